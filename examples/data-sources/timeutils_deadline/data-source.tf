@@ -0,0 +1,11 @@
+# Warn from 30 days before the reservation ends, and fail the plan in the last week
+data "timeutils_deadline" "reservation" {
+  name         = "Reserved instance ri-0abc"
+  timestamp    = "2024-02-01T00:00:00Z"
+  warn_within  = "30 days"
+  error_within = "7 days"
+}
+
+output "reservation_remaining" {
+  value = data.timeutils_deadline.reservation.remaining # e.g. "16 days, 13 hours and 30 minutes"
+}
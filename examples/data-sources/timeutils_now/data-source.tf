@@ -0,0 +1,19 @@
+# Pin the clock in tests with TIMEUTILS_FIXED_NOW=2024-01-15T10:30:00Z
+data "timeutils_now" "current" {
+  timezone = "Europe/Berlin"
+  zones    = ["America/New_York", "Asia/Tokyo"]
+
+  formats = {
+    date  = "%Y-%m-%d"
+    stamp = "%Y%m%d%H%M%S"
+  }
+}
+
+output "now" {
+  value = {
+    utc      = data.timeutils_now.current.rfc3339                                 # "2024-01-15T10:30:00Z"
+    berlin   = data.timeutils_now.current.local_time                              # "2024-01-15T11:30:00+01:00"
+    date     = data.timeutils_now.current.formatted.date                          # "2024-01-15"
+    new_york = data.timeutils_now.current.local_times["America/New_York"].rfc3339 # "2024-01-15T05:30:00-05:00"
+  }
+}
@@ -0,0 +1,19 @@
+data "timeutils_timezone" "berlin" {
+  name             = "Europe/Berlin"
+  timestamp        = "2024-01-15T10:30:00Z"
+  transition_count = 2
+}
+
+locals {
+  # Avoid scheduling maintenance within a day of a DST changeover
+  next_changeover = data.timeutils_timezone.berlin.transitions[0].timestamp
+}
+
+output "berlin" {
+  value = {
+    offset          = data.timeutils_timezone.berlin.offset       # "+01:00"
+    abbreviation    = data.timeutils_timezone.berlin.abbreviation # "CET"
+    is_dst          = data.timeutils_timezone.berlin.is_dst       # false
+    next_changeover = local.next_changeover                       # "2024-03-31T01:00:00Z"
+  }
+}
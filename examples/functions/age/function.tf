@@ -0,0 +1,17 @@
+locals {
+  account_age = jsondecode(provider::timeutils::age("2021-03-31", "2024-02-29"))
+}
+
+output "account_age" {
+  value = "${local.account_age.years}y ${local.account_age.months}m ${local.account_age.days}d" # "2y 11m 0d"
+  # The 31st is reached on the last day of shorter months, such as 29 February
+}
+
+output "full_months" {
+  value = local.account_age.total_months # "35"
+}
+
+output "leap_day_age" {
+  # With "mar1", a 29 February birthday is not reached until 1 March in common years
+  value = jsondecode(provider::timeutils::age("2000-02-29", "2023-02-28", "", "mar1")).years # "22"
+}
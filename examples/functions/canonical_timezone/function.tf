@@ -0,0 +1,11 @@
+locals {
+  # Legacy zone name from an older configuration
+  legacy_zone = "US/Eastern"
+
+  zone = provider::timeutils::canonical_timezone(local.legacy_zone)
+}
+
+output "zone" {
+  description = "Canonical IANA time zone"
+  value       = local.zone # "America/New_York"
+}
@@ -0,0 +1,12 @@
+variable "certificate_pem" {
+  type        = string
+  description = "Leaf certificate followed by its intermediates"
+}
+
+locals {
+  certificates = jsondecode(provider::timeutils::cert_validity(var.certificate_pem, plantimestamp()))
+  # [{ subject = "CN=www.example.com", not_after = "2024-04-01T00:00:00Z", remaining_seconds = "3600", ... }, ...]
+
+  # Rotate when any certificate in the chain expires within 30 days
+  rotate = anytrue([for cert in local.certificates : tonumber(cert.remaining_seconds) < 30 * 24 * 3600])
+}
@@ -0,0 +1,20 @@
+locals {
+  # Active Directory pwdLastSet is a Windows FILETIME
+  pwd_last_set = "133500744000000000"
+
+  pwd_last_set_rfc3339 = provider::timeutils::convert_epoch(local.pwd_last_set, "filetime", "rfc3339")
+  pwd_last_set_unix    = provider::timeutils::convert_epoch(local.pwd_last_set, "filetime", "unix")
+
+  # GPS time is ahead of UTC by the leap seconds accumulated since 1980
+  gps_seconds = provider::timeutils::convert_epoch("2024-01-18T18:00:00Z", "rfc3339", "gps")
+}
+
+output "pwd_last_set" {
+  description = "Password last set time"
+  value       = local.pwd_last_set_rfc3339 # "2024-01-18T18:00:00Z"
+}
+
+output "gps_seconds" {
+  description = "GPS seconds since 1980-01-06"
+  value       = local.gps_seconds # "1389636018"
+}
@@ -0,0 +1,16 @@
+variable "reserved_instance_end" {
+  type    = string
+  default = "2024-02-10T00:00:00Z"
+}
+
+output "renew_reserved_instance" {
+  # True when the end date is within 30 days of the reference, or has passed
+  value = provider::timeutils::expires_within(var.reserved_instance_end, "30 days", "2024-01-15T10:30:00Z") # true
+}
+
+check "certificate_expiry" {
+  assert {
+    condition     = !provider::timeutils::expires_within("2024-06-30", "P1M", plantimestamp())
+    error_message = "The certificate expires within a month."
+  }
+}
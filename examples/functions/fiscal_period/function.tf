@@ -0,0 +1,26 @@
+locals {
+  # 4-5-4 retail calendar of 52 or 53 weeks, named by the year it starts in
+  retail_calendar = jsonencode({
+    year_start = "sunday nearest end of january"
+    pattern    = "4-5-4"
+    year_label = "start"
+  })
+
+  # US federal fiscal year starting October 1st
+  federal_calendar = jsonencode({
+    year_start = "10-01"
+    timezone   = "America/New_York"
+  })
+
+  retail  = jsondecode(provider::timeutils::fiscal_period("2024-05-15T12:00:00Z", local.retail_calendar))
+  federal = jsondecode(provider::timeutils::fiscal_period("2024-05-15T12:00:00Z", local.federal_calendar))
+}
+
+output "cost_allocation_tag" {
+  description = "Fiscal period tag for cost allocation"
+  value       = "FY${local.retail.fiscal_year}-P${local.retail.period}" # "FY2024-P4"
+}
+
+output "federal_fiscal_quarter" {
+  value = "FY${local.federal.fiscal_year} Q${local.federal.quarter}" # "FY2024 Q3"
+}
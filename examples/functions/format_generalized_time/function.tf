@@ -0,0 +1,9 @@
+output "utc" {
+  value = provider::timeutils::format_generalized_time("2024-01-15T10:30:00.120+05:30")
+  # Returns: "20240115050000.12Z"
+}
+
+output "local" {
+  value = provider::timeutils::format_generalized_time("2024-01-15T10:30:00Z", "America/New_York")
+  # Returns: "20240115053000-0500"
+}
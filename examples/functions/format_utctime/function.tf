@@ -0,0 +1,10 @@
+# Years outside 1950 to 2049 are an error; use format_generalized_time for those
+output "not_after" {
+  value = provider::timeutils::format_utctime("2024-01-15T10:30:00+05:30")
+  # Returns: "240115050000Z"
+}
+
+output "local" {
+  value = provider::timeutils::format_utctime("2024-07-15T10:30:00Z", "Europe/Berlin")
+  # Returns: "240715123000+0200"
+}
@@ -0,0 +1,9 @@
+locals {
+  # Julian Days start at noon UTC
+  observed_at = provider::timeutils::from_julian_day("2460325.1875")
+}
+
+output "observed_at" {
+  description = "RFC3339 timestamp of the Julian Day"
+  value       = local.observed_at # "2024-01-15T16:30:00Z"
+}
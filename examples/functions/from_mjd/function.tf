@@ -0,0 +1,15 @@
+locals {
+  # Modified Julian Dates start at midnight UTC
+  partition_start = provider::timeutils::from_mjd("60324")
+  observed_at     = provider::timeutils::from_mjd("60324.6875")
+}
+
+output "partition_start" {
+  description = "Start of the MJD partition"
+  value       = local.partition_start # "2024-01-15T00:00:00Z"
+}
+
+output "observed_at" {
+  description = "Timestamp of a fractional MJD"
+  value       = local.observed_at # "2024-01-15T16:30:00Z"
+}
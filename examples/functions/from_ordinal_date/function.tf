@@ -0,0 +1,16 @@
+locals {
+  day_start = provider::timeutils::from_ordinal_date("2024-015")
+
+  # A decimal fraction of the day is added to the start of the day
+  quarter_day = provider::timeutils::from_ordinal_date("2024-015.25")
+}
+
+output "day_start" {
+  description = "Start of day 15 of 2024"
+  value       = local.day_start # "2024-01-15T00:00:00Z"
+}
+
+output "quarter_day" {
+  description = "A quarter of the way through day 15 of 2024"
+  value       = local.quarter_day # "2024-01-15T06:00:00Z"
+}
@@ -0,0 +1,11 @@
+locals {
+  # Timestamp reported by a GPS-disciplined device
+  device_time = "2024-01-18T18:00:18Z"
+
+  event_utc = provider::timeutils::gps_to_utc(local.device_time)
+}
+
+output "event_utc" {
+  description = "UTC timestamp of the device reading"
+  value       = local.event_utc # "2024-01-18T18:00:00Z"
+}
@@ -0,0 +1,16 @@
+variable "timezone" {
+  description = "IANA time zone used across the platform"
+  type        = string
+  default     = "Europe/Berlin"
+}
+
+locals {
+  # Azure VM auto-shutdown schedules and maintenance configurations
+  # require a Windows time zone ID
+  windows_timezone = provider::timeutils::iana_to_windows(var.timezone)
+}
+
+output "windows_timezone" {
+  description = "Windows time zone ID for Azure resources"
+  value       = local.windows_timezone # "W. Europe Standard Time"
+}
@@ -0,0 +1,20 @@
+locals {
+  contract = { start = "2024-01-01T00:00:00Z", end = "2025-01-01T00:00:00Z" }
+}
+
+output "renewal_in_contract" {
+  description = "Whether the renewal date falls within the contract term"
+  value       = provider::timeutils::interval_contains(local.contract, "2024-12-01T00:00:00Z") # true
+}
+
+output "term_end_in_contract" {
+  description = "The end of a half-open interval is not part of it"
+  value       = provider::timeutils::interval_contains(local.contract, "2025-01-01T00:00:00Z") # false
+}
+
+output "quarter_in_contract" {
+  value = provider::timeutils::interval_contains(local.contract, {
+    start = "2024-10-01T00:00:00Z"
+    end   = "2025-01-01T00:00:00Z"
+  }) # true
+}
@@ -0,0 +1,17 @@
+locals {
+  bookings = [
+    { start = "2024-01-15T09:00:00Z", end = "2024-01-15T11:00:00Z" },
+    { start = "2024-01-15T13:00:00Z", end = "2024-01-15T14:00:00Z" },
+  ]
+
+  working_day = { start = "2024-01-15T08:00:00Z", end = "2024-01-15T17:00:00Z" }
+}
+
+output "free_slots" {
+  value = provider::timeutils::interval_gaps(local.bookings, local.working_day)
+  # [
+  #   { start = "2024-01-15T08:00:00Z", end = "2024-01-15T09:00:00Z" },
+  #   { start = "2024-01-15T11:00:00Z", end = "2024-01-15T13:00:00Z" },
+  #   { start = "2024-01-15T14:00:00Z", end = "2024-01-15T17:00:00Z" },
+  # ]
+}
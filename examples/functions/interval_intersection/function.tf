@@ -0,0 +1,11 @@
+locals {
+  change_window = { start = "2024-12-20T22:00:00Z", end = "2024-12-21T02:00:00Z" }
+  on_call_shift = { start = "2024-12-21T00:00:00Z", end = "2024-12-21T08:00:00Z" }
+
+  covered = provider::timeutils::interval_intersection(local.change_window, local.on_call_shift)
+}
+
+output "covered_window" {
+  description = "Part of the change window covered by the on-call shift, or null"
+  value       = local.covered # { start = "2024-12-21T00:00:00Z", end = "2024-12-21T02:00:00Z" }
+}
@@ -0,0 +1,8 @@
+locals {
+  maintenance = { start = "2024-12-20T22:00:00Z", end = "2024-12-21T02:00:00Z" }
+  freeze      = { start = "2024-12-21T00:00:00Z", end = "2025-01-02T00:00:00Z" }
+}
+
+output "maintenance_during_freeze" {
+  value = provider::timeutils::interval_overlaps(local.maintenance, local.freeze) # true
+}
@@ -0,0 +1,19 @@
+locals {
+  maintenance_windows = [
+    { start = "2024-12-01T00:00:00Z", end = "2024-12-08T00:00:00Z" },
+    { start = "2024-12-15T00:00:00Z", end = "2025-01-15T00:00:00Z" },
+  ]
+
+  freeze_periods = [
+    { start = "2024-12-20T00:00:00Z", end = "2025-01-02T00:00:00Z" },
+  ]
+}
+
+output "allowed_windows" {
+  value = provider::timeutils::interval_subtract(local.maintenance_windows, local.freeze_periods)
+  # [
+  #   { start = "2024-12-01T00:00:00Z", end = "2024-12-08T00:00:00Z" },
+  #   { start = "2024-12-15T00:00:00Z", end = "2024-12-20T00:00:00Z" },
+  #   { start = "2025-01-02T00:00:00Z", end = "2025-01-15T00:00:00Z" },
+  # ]
+}
@@ -0,0 +1,15 @@
+locals {
+  freeze_periods = [
+    { start = "2024-12-20T00:00:00Z", end = "2025-01-02T00:00:00Z" },
+    { start = "2024-11-28T00:00:00Z", end = "2024-12-02T00:00:00Z" },
+    { start = "2024-12-30T00:00:00Z", end = "2025-01-06T00:00:00Z" },
+  ]
+}
+
+output "freeze_calendar" {
+  value = provider::timeutils::interval_union(local.freeze_periods)
+  # [
+  #   { start = "2024-11-28T00:00:00Z", end = "2024-12-02T00:00:00Z" },
+  #   { start = "2024-12-20T00:00:00Z", end = "2025-01-06T00:00:00Z" },
+  # ]
+}
@@ -0,0 +1,14 @@
+variable "timezone" {
+  description = "IANA time zone for the maintenance window"
+  type        = string
+  default     = "Europe/London"
+
+  validation {
+    condition     = provider::timeutils::is_valid_timezone(var.timezone)
+    error_message = "The timezone must be an IANA time zone name such as Europe/London."
+  }
+}
+
+output "timezone" {
+  value = var.timezone
+}
@@ -0,0 +1,11 @@
+locals {
+  # December 30th 2024 is in week 1 of ISO year 2025
+  week = jsondecode(provider::timeutils::iso_week("2024-12-30T10:00:00Z"))
+
+  index_name = format("logs-%s-w%02d", local.week.iso_year, tonumber(local.week.week))
+}
+
+output "index_name" {
+  description = "Weekly index name"
+  value       = local.index_name # "logs-2025-w01"
+}
@@ -0,0 +1,8 @@
+locals {
+  week_start = provider::timeutils::iso_week_start("2025", "1")
+}
+
+output "week_start" {
+  description = "Monday starting ISO week 2025-W01"
+  value       = local.week_start # "2024-12-30T00:00:00Z"
+}
@@ -0,0 +1,13 @@
+variable "service_account_token" {
+  type      = string
+  sensitive = true
+}
+
+locals {
+  # The result is sensitive because the token is
+  token_times = jsondecode(provider::timeutils::jwt_times(var.service_account_token, plantimestamp()))
+  # { iat = "2024-01-15T10:30:00Z", exp = "2024-01-15T11:30:00Z", remaining_seconds = "1800" }
+
+  # Only the expiry is needed outside the module, so it is safe to reveal
+  token_expires_at = nonsensitive(local.token_times.exp)
+}
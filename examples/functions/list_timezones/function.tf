@@ -0,0 +1,13 @@
+locals {
+  # Every zone used in Australia, with its offset at plan time
+  australian_zones = jsondecode(provider::timeutils::list_timezones("AU", plantimestamp()))
+
+  zone_offsets = {
+    for zone in local.australian_zones : zone.name => zone.offset
+  }
+}
+
+output "australian_zone_offsets" {
+  description = "Current UTC offset of each Australian time zone"
+  value       = local.zone_offsets # { "Australia/Brisbane" = "+10:00", ... }
+}
@@ -0,0 +1,18 @@
+locals {
+  # 02:30 does not exist in New York on the spring-forward day
+  shifted_later = provider::timeutils::local_to_utc("2024-03-10T02:30:00", "America/New_York", "later")
+
+  # 01:30 happens twice in New York on the fall-back day
+  first_occurrence = provider::timeutils::local_to_utc("2024-11-03T01:30:00", "America/New_York", "earlier")
+
+  # Fail the plan instead of silently shifting the time
+  strict = provider::timeutils::local_to_utc("2024-01-15T09:00:00", "America/New_York", "reject")
+}
+
+output "maintenance_windows" {
+  value = {
+    shifted_later    = local.shifted_later    # "2024-03-10T07:30:00Z"
+    first_occurrence = local.first_occurrence # "2024-11-03T05:30:00Z"
+    strict           = local.strict           # "2024-01-15T14:00:00Z"
+  }
+}
@@ -0,0 +1,12 @@
+variable "contract_signed" {
+  type    = string
+  default = "2021-06-30"
+}
+
+output "next_renewal" {
+  value = provider::timeutils::next_anniversary(var.contract_signed, "2024-01-10T00:00:00Z") # "2024-06-30T00:00:00Z"
+}
+
+output "next_three_year_review" {
+  value = provider::timeutils::next_anniversary(var.contract_signed, "2024-07-01T00:00:00Z", "3") # "2027-06-30T00:00:00Z"
+}
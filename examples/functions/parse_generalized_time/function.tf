@@ -0,0 +1,11 @@
+# LDAP whenCreated attribute
+output "created" {
+  value = provider::timeutils::parse_generalized_time("20240115103000.0Z")
+  # Returns: "2024-01-15T10:30:00Z"
+}
+
+# Values without a Z or offset are local times in the given zone
+output "local" {
+  value = provider::timeutils::parse_generalized_time("20240715103000", "Europe/Berlin")
+  # Returns: "2024-07-15T10:30:00+02:00"
+}
@@ -0,0 +1,20 @@
+variable "maintenance_window" {
+  type    = string
+  default = "next tuesday 09:00"
+}
+
+# Resolve against a fixed reference so the result only changes with the inputs
+output "maintenance_start" {
+  value = provider::timeutils::parse_natural(var.maintenance_window, "2024-01-17T10:30:00Z", "Europe/London")
+  # Returns: "2024-01-23T09:00:00Z"
+}
+
+output "review_by" {
+  value = provider::timeutils::parse_natural("in 2 weeks", "2024-01-17T10:30:00Z")
+  # Returns: "2024-01-31T10:30:00Z"
+}
+
+output "billing_cutoff" {
+  value = provider::timeutils::parse_natural("end of month", "2024-01-17T10:30:00-05:00")
+  # Returns: "2024-01-31T23:59:59-05:00"
+}
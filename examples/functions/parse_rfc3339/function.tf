@@ -12,13 +12,15 @@ locals {
 output "timestamp_components" {
   description = "Map of timestamp components"
   value = {
-    year    = parseint(local.parsed.year, 10)
-    month   = parseint(local.parsed.month, 10)
-    day     = parseint(local.parsed.day, 10)
-    hour    = parseint(local.parsed.hour, 10)
-    minute  = parseint(local.parsed.minute, 10)
-    second  = parseint(local.parsed.second, 10)
-    unix    = parseint(local.parsed.unix, 10)
-    weekday = parseint(local.parsed.weekday, 10) # 0=Sunday, 1=Monday, etc.
+    year        = parseint(local.parsed.year, 10)
+    month       = parseint(local.parsed.month, 10)
+    day         = parseint(local.parsed.day, 10)
+    hour        = parseint(local.parsed.hour, 10)
+    minute      = parseint(local.parsed.minute, 10)
+    second      = parseint(local.parsed.second, 10)
+    unix        = parseint(local.parsed.unix, 10)
+    weekday     = parseint(local.parsed.weekday, 10) # 0=Sunday, 1=Monday, etc.
+    day_of_year = parseint(local.parsed.day_of_year, 10)
+    julian_day  = tonumber(local.parsed.julian_day)
   }
 }
\ No newline at end of file
@@ -0,0 +1,22 @@
+# Last-Modified header from an http data source
+output "last_modified" {
+  value = provider::timeutils::parse_timestamp("Mon, 15 Jan 2024 10:30:00 GMT", "http_date")
+  # Returns: "2024-01-15T10:30:00Z"
+}
+
+# Apache or Nginx access log timestamp
+output "access_log" {
+  value = provider::timeutils::parse_timestamp("[15/Jan/2024:10:30:00 +0100]", "clf")
+  # Returns: "2024-01-15T10:30:00+01:00"
+}
+
+# BSD syslog timestamps have no year; it is inferred from the reference
+output "syslog" {
+  value = provider::timeutils::parse_timestamp("<34>Dec 31 23:59:59 host app: started", "syslog_rfc3164", "Europe/London", "2024-01-01T00:10:00Z")
+  # Returns: "2023-12-31T23:59:59Z"
+}
+
+output "syslog_past" {
+  value = provider::timeutils::parse_timestamp("Jan  1 00:00:05", "syslog_rfc3164", "", "2023-12-31T23:59:00Z", "past")
+  # Returns: "2023-01-01T00:00:05Z"
+}
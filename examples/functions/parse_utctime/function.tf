@@ -0,0 +1,9 @@
+output "not_after" {
+  value = provider::timeutils::parse_utctime("491231235959Z")
+  # Returns: "2049-12-31T23:59:59Z"
+}
+
+output "not_before" {
+  value = provider::timeutils::parse_utctime("991231235959Z")
+  # Returns: "1999-12-31T23:59:59Z"
+}
@@ -0,0 +1,24 @@
+locals {
+  timestamp = "2024-05-15T12:00:00Z"
+
+  quarter     = jsondecode(provider::timeutils::period_bounds(local.timestamp, "quarter"))
+  fiscal_year = jsondecode(provider::timeutils::period_bounds(local.timestamp, "fiscal_year:april", "Europe/London"))
+  us_week     = jsondecode(provider::timeutils::period_bounds(local.timestamp, "week", "America/New_York", "sunday"))
+}
+
+output "quarter_last_instant" {
+  description = "Last instant of the current quarter"
+  value       = local.quarter.end_inclusive # "2024-06-30T23:59:59.999999999Z"
+}
+
+output "fiscal_year" {
+  description = "UK fiscal year containing the timestamp"
+  value = {
+    start = local.fiscal_year.start # "2024-04-01T00:00:00+01:00"
+    end   = local.fiscal_year.end   # "2025-04-01T00:00:00+01:00"
+  }
+}
+
+output "us_week_start" {
+  value = local.us_week.start # "2024-05-12T00:00:00-04:00"
+}
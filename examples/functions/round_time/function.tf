@@ -0,0 +1,14 @@
+locals {
+  timestamp = "2024-08-15T14:38:00Z"
+
+  nearest_quarter_hour = provider::timeutils::round_time(local.timestamp, "15m", "nearest")
+  next_hour_in_india   = provider::timeutils::round_time(local.timestamp, "hour", "ceil", "Asia/Kolkata")
+}
+
+output "nearest_quarter_hour" {
+  value = local.nearest_quarter_hour # "2024-08-15T14:45:00Z"
+}
+
+output "next_hour_in_india" {
+  value = local.next_hour_in_india # "2024-08-15T21:00:00+05:30"
+}
@@ -0,0 +1,21 @@
+variable "leap_seconds_list" {
+  description = "Optional contents of an up to date leap-seconds.list"
+  type        = string
+  default     = null
+}
+
+locals {
+  event_utc = provider::timeutils::tai_to_utc("2024-01-18T18:00:37Z")
+
+  # Supply a newer leap second table than the one built into the provider
+  event_utc_override = (
+    var.leap_seconds_list == null
+    ? local.event_utc
+    : provider::timeutils::tai_to_utc("2024-01-18T18:00:37Z", var.leap_seconds_list)
+  )
+}
+
+output "event_utc" {
+  description = "UTC timestamp of the event"
+  value       = local.event_utc_override # "2024-01-18T18:00:00Z"
+}
@@ -0,0 +1,9 @@
+locals {
+  freeze_start = "2024-12-20"
+  freeze_end   = "2025-01-06"
+}
+
+output "deploy_frozen" {
+  # The end is excluded, so deployments resume at midnight UTC on 6 January
+  value = provider::timeutils::time_between("2024-12-24T15:00:00-08:00", local.freeze_start, local.freeze_end) # true
+}
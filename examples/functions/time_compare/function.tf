@@ -0,0 +1,12 @@
+locals {
+  # 10:30 in Berlin is before 09:45 UTC, although it sorts after it lexically
+  order = provider::timeutils::time_compare("2024-01-15T10:30:00+01:00", "2024-01-15T09:45:00Z")
+}
+
+output "order" {
+  value = local.order # "-1"
+}
+
+output "same_instant" {
+  value = provider::timeutils::time_compare("2024-01-15", "2024-01-15T00:00:00Z") == "0" # true
+}
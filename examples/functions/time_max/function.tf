@@ -0,0 +1,12 @@
+variable "backup_times" {
+  type = list(string)
+  default = [
+    "2024-01-15T09:00:00Z",
+    "2024-01-15T10:30:00+02:00",
+    "2024-01-15T03:00:00-05:00",
+  ]
+}
+
+output "newest_backup" {
+  value = provider::timeutils::time_max(var.backup_times) # "2024-01-15T09:00:00Z"
+}
@@ -0,0 +1,22 @@
+locals {
+  # Every day at 09:00 in New York, including across the March DST change
+  daily_runs = provider::timeutils::time_range("2024-03-09T09:00:00-05:00", "2024-03-12T00:00:00Z", "1 day", "America/New_York")
+
+  # Month starts for the rest of the year
+  months = provider::timeutils::time_range("2024-09-01T00:00:00Z", "2025-01-01T00:00:00Z", "P1M")
+}
+
+resource "terraform_data" "monthly_report" {
+  for_each = toset(local.months)
+
+  input = provider::timeutils::strftime("%Y-%m", each.value)
+}
+
+output "daily_runs" {
+  value = local.daily_runs
+  # [
+  #   "2024-03-09T09:00:00-05:00",
+  #   "2024-03-10T09:00:00-04:00",
+  #   "2024-03-11T09:00:00-04:00",
+  # ]
+}
@@ -0,0 +1,21 @@
+variable "release_times" {
+  type = list(string)
+  default = [
+    "2024-01-15T10:30:00+02:00",
+    "2024-01-15T09:00:00Z",
+    "2024-01-15T03:00:00-05:00",
+  ]
+}
+
+output "chronological" {
+  value = provider::timeutils::time_sort(var.release_times)
+  # [
+  #   "2024-01-15T03:00:00-05:00",
+  #   "2024-01-15T10:30:00+02:00",
+  #   "2024-01-15T09:00:00Z",
+  # ]
+}
+
+output "latest_first" {
+  value = provider::timeutils::time_sort(var.release_times, true)
+}
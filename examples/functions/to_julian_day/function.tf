@@ -0,0 +1,11 @@
+locals {
+  observed_at = "2024-01-15T16:30:00Z"
+
+  # Julian Day as a decimal string
+  julian_day = provider::timeutils::to_julian_day(local.observed_at)
+}
+
+output "julian_day" {
+  description = "Julian Day of the observation"
+  value       = local.julian_day # "2460325.1875"
+}
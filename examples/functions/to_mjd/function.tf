@@ -0,0 +1,12 @@
+locals {
+  observed_at = "2024-01-15T16:30:00Z"
+
+  # Partition by whole Modified Julian Date
+  mjd       = provider::timeutils::to_mjd(local.observed_at)
+  partition = "mjd=${floor(tonumber(local.mjd))}"
+}
+
+output "partition" {
+  description = "Partition key for the observation"
+  value       = local.partition # "mjd=60324"
+}
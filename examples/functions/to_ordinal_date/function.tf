@@ -0,0 +1,12 @@
+locals {
+  observed_at = "2024-02-29T12:00:00Z"
+
+  # ISO 8601 ordinal date (YYYY-DDD)
+  ordinal_date = provider::timeutils::to_ordinal_date(local.observed_at)
+  day_of_year  = split("-", local.ordinal_date)[1]
+}
+
+output "ordinal_date" {
+  description = "Year and day of year of the observation"
+  value       = local.ordinal_date # "2024-060"
+}
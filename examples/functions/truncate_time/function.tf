@@ -0,0 +1,19 @@
+locals {
+  timestamp = "2024-08-15T03:30:00Z"
+
+  # Start of the ISO week in UTC
+  week_start = provider::timeutils::truncate_time(local.timestamp, "week")
+
+  # Start of the local day in New York, where it is still August 14th
+  local_day = provider::timeutils::truncate_time(local.timestamp, "day", "America/New_York")
+}
+
+output "snapshot_bucket" {
+  description = "Weekly bucket for snapshot names"
+  value       = local.week_start # "2024-08-12T00:00:00Z"
+}
+
+output "local_day" {
+  description = "Start of the local day"
+  value       = local.local_day # "2024-08-14T00:00:00-04:00"
+}
@@ -0,0 +1,14 @@
+locals {
+  # Every offset change in London during 2024
+  transitions = jsondecode(provider::timeutils::tz_transitions(
+    "Europe/London",
+    "2024-01-01T00:00:00Z",
+    "2024-12-31T23:59:59Z",
+  ))
+}
+
+output "dst_changes" {
+  description = "UTC instants at which London changes offset"
+  value       = [for t in local.transitions : "${t.timestamp} (${t.abbreviation})"]
+  # ["2024-03-31T01:00:00Z (BST)", "2024-10-27T01:00:00Z (GMT)"]
+}
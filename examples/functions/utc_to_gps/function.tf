@@ -0,0 +1,9 @@
+locals {
+  # GPS time has not counted leap seconds since 1980-01-06
+  event_gps = provider::timeutils::utc_to_gps("2024-01-18T18:00:00Z")
+}
+
+output "event_gps" {
+  description = "GPS clock reading of the event"
+  value       = local.event_gps # "2024-01-18T18:00:18Z"
+}
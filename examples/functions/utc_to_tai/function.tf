@@ -0,0 +1,19 @@
+locals {
+  event_utc = "2024-01-18T18:00:00Z"
+
+  # TAI is ahead of UTC by the accumulated leap seconds
+  event_tai = provider::timeutils::utc_to_tai(local.event_utc)
+
+  # Leap seconds themselves are accepted
+  leap_tai = provider::timeutils::utc_to_tai("2016-12-31T23:59:60Z")
+}
+
+output "event_tai" {
+  description = "TAI clock reading of the event"
+  value       = local.event_tai # "2024-01-18T18:00:37Z"
+}
+
+output "leap_tai" {
+  description = "TAI clock reading of the 2016 leap second"
+  value       = local.leap_tai # "2017-01-01T00:00:36Z"
+}
@@ -0,0 +1,13 @@
+locals {
+  timestamp = "2024-09-02T12:00:00Z"
+
+  week_monday = provider::timeutils::week_of_month(local.timestamp)
+  week_sunday = provider::timeutils::week_of_month(local.timestamp, "sunday")
+}
+
+output "week_of_month" {
+  value = {
+    monday_start = local.week_monday # "2"
+    sunday_start = local.week_sunday # "1"
+  }
+}
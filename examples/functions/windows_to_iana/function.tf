@@ -0,0 +1,17 @@
+locals {
+  # Time zone reported by an Azure VM
+  vm_timezone = "W. Europe Standard Time"
+
+  iana_zone       = provider::timeutils::windows_to_iana(local.vm_timezone)
+  iana_zone_swiss = provider::timeutils::windows_to_iana(local.vm_timezone, "CH")
+}
+
+output "iana_zone" {
+  description = "IANA time zone of the VM"
+  value       = local.iana_zone # "Europe/Berlin"
+}
+
+output "iana_zone_swiss" {
+  description = "IANA time zone of the VM in Switzerland"
+  value       = local.iana_zone_swiss # "Europe/Zurich"
+}
@@ -0,0 +1,35 @@
+# Rotate an API key at 09:00 London time every Monday
+resource "timeutils_rotating" "api_key" {
+  timezone      = "Europe/London"
+  rotation_cron = "0 9 * * mon"
+
+  formats = {
+    label = "%Y-%m-%d"
+  }
+}
+
+# Rotate a shared secret on the first Monday of every quarter
+resource "timeutils_rotating" "quarterly" {
+  rotation_first_weekday_of_quarter = "monday"
+}
+
+# Rotate a password every 10 business days
+resource "timeutils_rotating" "password" {
+  rotation_business_days = 10
+}
+
+resource "terraform_data" "api_key" {
+  input = "api-key-${timeutils_rotating.api_key.formatted.label}"
+
+  lifecycle {
+    replace_triggered_by = [timeutils_rotating.api_key]
+  }
+}
+
+output "api_key_rotation" {
+  value = {
+    created      = timeutils_rotating.api_key.rfc3339
+    rotates_at   = timeutils_rotating.api_key.rotation_rfc3339
+    rotates_next = timeutils_rotating.api_key.next_rotation_rfc3339
+  }
+}
@@ -0,0 +1,24 @@
+# The next maintenance windows, at 02:00 on weekdays in Berlin
+resource "timeutils_schedule" "maintenance" {
+  cron     = "0 2 * * mon-fri"
+  timezone = "Europe/Berlin"
+  horizon  = "2 weeks"
+  count    = 5
+}
+
+# Payroll on the last weekday of each month, for the next year
+resource "timeutils_schedule" "payroll" {
+  rrule    = "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;BYHOUR=17;BYMINUTE=0"
+  start    = "2024-01-01T00:00:00Z"
+  timezone = "America/New_York"
+  horizon  = "P1Y"
+  count    = 12
+}
+
+output "next_maintenance" {
+  value = timeutils_schedule.maintenance.next_occurrence
+}
+
+output "payroll_dates" {
+  value = timeutils_schedule.payroll.occurrences
+}
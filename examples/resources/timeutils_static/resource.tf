@@ -0,0 +1,27 @@
+# Capture the time the environment was first created
+resource "timeutils_static" "created" {
+  zones = ["Europe/Berlin", "America/New_York"]
+
+  formats = {
+    date       = "%Y-%m-%d"
+    index_name = "logs-%Y.%m.%d"
+  }
+}
+
+# Pin a given timestamp and derive its fields
+resource "timeutils_static" "launch" {
+  rfc3339 = "2024-12-30T23:30:00-05:00"
+}
+
+output "created" {
+  value = {
+    unix       = timeutils_static.created.unix
+    date       = timeutils_static.created.formatted.date
+    berlin     = timeutils_static.created.local_times["Europe/Berlin"].rfc3339
+    index_name = timeutils_static.created.formatted.index_name
+  }
+}
+
+output "launch_iso_week" {
+  value = "${timeutils_static.launch.iso_year}-W${format("%02d", timeutils_static.launch.iso_week)}" # "2025-W01"
+}
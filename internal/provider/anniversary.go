@@ -0,0 +1,89 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Leap day handling for anniversaries of a day that does not exist in the
+// target month, most commonly 29 February in a common year.
+const (
+	leapDayFeb28 = "feb28"
+	leapDayMar1  = "mar1"
+)
+
+var leapDayPolicies = []string{leapDayFeb28, leapDayMar1}
+
+// parseLeapDayPolicy parses a leap day handling name, defaulting to feb28
+// when empty.
+func parseLeapDayPolicy(value string) (string, error) {
+	switch value {
+	case "":
+		return leapDayFeb28, nil
+	case leapDayFeb28, leapDayMar1:
+		return value, nil
+	default:
+		return "", fmt.Errorf("unknown leap day handling %q, expected one of: %s", value, strings.Join(leapDayPolicies, ", "))
+	}
+}
+
+// anniversary adds months to wall clock fields. A day missing from the
+// resulting month falls on its last day under feb28 and on the first day of
+// the following month under mar1.
+func anniversary(wall time.Time, months int, policy string) time.Time {
+	clamped := addMonthsClamped(wall, months)
+	if policy == leapDayMar1 && clamped.Day() < wall.Day() {
+		return clamped.AddDate(0, 0, 1)
+	}
+
+	return clamped
+}
+
+// calendarAge is the whole calendar months and remaining days from one wall
+// clock time to a later one.
+type calendarAge struct {
+	months int
+	days   int
+}
+
+// ageBetween returns the calendar age at wall clock time reference of
+// something that started at wall clock time birth. A month is only complete
+// once its monthly anniversary, including the time of day, has been reached.
+func ageBetween(birth, reference time.Time, policy string) (calendarAge, error) {
+	if reference.Before(birth) {
+		return calendarAge{}, fmt.Errorf("reference %s is before %s", reference.Format(time.DateTime), birth.Format(time.DateTime))
+	}
+
+	months := (reference.Year()-birth.Year())*12 + int(reference.Month()-birth.Month())
+	for months > 0 && anniversary(birth, months, policy).After(reference) {
+		months--
+	}
+
+	return calendarAge{
+		months: months,
+		days:   int(reference.Sub(anniversary(birth, months, policy)) / (24 * time.Hour)),
+	}, nil
+}
+
+// nextAnniversary returns the first anniversary of wall clock time date
+// after wall clock time reference, counting only every intervalYears years.
+func nextAnniversary(date, reference time.Time, intervalYears int, policy string) (time.Time, error) {
+	n := max(1, (reference.Year()-date.Year())/intervalYears)
+
+	next := anniversary(date, 12*intervalYears*n, policy)
+	for !next.After(reference) {
+		n++
+		next = anniversary(date, 12*intervalYears*n, policy)
+	}
+
+	if next.Year() > 9999 {
+		return time.Time{}, errors.New("next anniversary is after year 9999")
+	}
+
+	return next, nil
+}
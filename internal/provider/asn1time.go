@@ -0,0 +1,160 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// generalizedTimePattern matches ASN.1 GeneralizedTime: YYYYMMDDHH with
+// optional minutes and seconds, an optional fraction of the last unit after
+// a period or comma, and an optional Z or +HH[MM]/-HH[MM] suffix.
+var generalizedTimePattern = regexp.MustCompile(`^(\d{4})(\d{2})(\d{2})(\d{2})(?:(\d{2})(\d{2})?)?(?:[.,](\d+))?(Z|[+-]\d{2}(?:\d{2})?)?$`)
+
+// utcTimePattern matches ASN.1 UTCTime: YYMMDDHHMM with optional seconds and
+// a required Z or +HHMM/-HHMM suffix.
+var utcTimePattern = regexp.MustCompile(`^(\d{2})(\d{2})(\d{2})(\d{2})(\d{2})(\d{2})?(Z|[+-]\d{4})$`)
+
+// parseGeneralizedTime parses an ASN.1 GeneralizedTime such as
+// "20240115103000.0Z". A fraction applies to the last unit present, so
+// "2024011510.5Z" is 10:30. Values without a suffix are local times on the
+// wall clock of loc, resolved with the compatible policy.
+func parseGeneralizedTime(value string, loc *time.Location) (time.Time, error) {
+	m := generalizedTimePattern.FindStringSubmatch(value)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("%q is not a GeneralizedTime in YYYYMMDDHH[MM[SS]][.fff][Z|+HHMM] form", value)
+	}
+
+	year, _ := strconv.Atoi(m[1])
+
+	wall, err := asn1Wall(year, m[2], m[3], m[4], m[5], m[6])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q: %w", value, err)
+	}
+
+	if m[7] != "" {
+		unit := time.Second
+		switch {
+		case m[5] == "":
+			unit = time.Hour
+		case m[6] == "":
+			unit = time.Minute
+		}
+
+		if len(m[7]) > 9 {
+			return time.Time{}, fmt.Errorf("%q: fraction must have at most 9 digits", value)
+		}
+
+		// The fraction in billionths, scaled to the unit it applies to.
+		billionths, _ := strconv.Atoi(m[7] + strings.Repeat("0", 9-len(m[7])))
+		wall = wall.Add(time.Duration(billionths) * (unit / time.Second))
+	}
+
+	if m[8] == "" {
+		t, err := resolveLocalTime(wall, loc, disambiguationCompatible)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return t.In(loc), nil
+	}
+
+	return asn1Offset(wall, m[8], value)
+}
+
+// parseUTCTime parses an ASN.1 UTCTime such as "240115103000Z". Two-digit
+// years from 50 to 99 are 1950 to 1999 and from 00 to 49 are 2000 to 2049,
+// as in RFC 5280.
+func parseUTCTime(value string) (time.Time, error) {
+	m := utcTimePattern.FindStringSubmatch(value)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("%q is not a UTCTime in YYMMDDHHMM[SS](Z|+HHMM) form", value)
+	}
+
+	year, _ := strconv.Atoi(m[1])
+	if year < 50 {
+		year += 2000
+	} else {
+		year += 1900
+	}
+
+	wall, err := asn1Wall(year, m[2], m[3], m[4], m[5], m[6])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q: %w", value, err)
+	}
+
+	return asn1Offset(wall, m[7], value)
+}
+
+// asn1Wall builds a wall clock time in UTC from the digit groups of an ASN.1
+// time, where minute and second may be empty, rejecting out of range fields
+// rather than normalizing them.
+func asn1Wall(year int, month, day, hour, minute, second string) (time.Time, error) {
+	n := func(s string) int {
+		v, _ := strconv.Atoi(s)
+		return v
+	}
+
+	wall := time.Date(year, time.Month(n(month)), n(day), n(hour), n(minute), n(second), 0, time.UTC)
+	if int(wall.Month()) != n(month) || wall.Day() != n(day) || wall.Hour() != n(hour) || wall.Minute() != n(minute) || wall.Second() != n(second) {
+		return time.Time{}, errors.New("date or time is out of range")
+	}
+
+	return wall, nil
+}
+
+// asn1Offset applies a Z, +HH or +HHMM suffix to wall clock fields.
+func asn1Offset(wall time.Time, suffix, value string) (time.Time, error) {
+	if suffix == "Z" {
+		return wall, nil
+	}
+
+	hours, _ := strconv.Atoi(suffix[1:3])
+	minutes := 0
+	if len(suffix) == 5 {
+		minutes, _ = strconv.Atoi(suffix[3:5])
+	}
+
+	if hours > 23 || minutes > 59 {
+		return time.Time{}, fmt.Errorf("%q: UTC offset %s is out of range", value, suffix)
+	}
+
+	offset := hours*3600 + minutes*60
+	if suffix[0] == '-' {
+		offset = -offset
+	}
+
+	return time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(),
+		time.FixedZone("", offset)), nil
+}
+
+// formatGeneralizedTime formats t as a GeneralizedTime with seconds and any
+// fraction without trailing zeros. At a zero offset the suffix is Z, as DER
+// requires, and otherwise the offset of t as +HHMM.
+func formatGeneralizedTime(t time.Time) string {
+	if _, offset := t.Zone(); offset == 0 {
+		return t.Format("20060102150405.999999999") + "Z"
+	}
+
+	return t.Format("20060102150405.999999999-0700")
+}
+
+// formatUTCTime formats t as a UTCTime with seconds, dropping any fraction,
+// with the Z or +HHMM suffix chosen as in formatGeneralizedTime. Only years
+// 1950 to 2049 can be represented.
+func formatUTCTime(t time.Time) (string, error) {
+	if t.Year() < 1950 || t.Year() > 2049 {
+		return "", fmt.Errorf("year %d is outside the UTCTime range 1950 to 2049", t.Year())
+	}
+
+	if _, offset := t.Zone(); offset == 0 {
+		return t.Format("060102150405") + "Z", nil
+	}
+
+	return t.Format("060102150405-0700"), nil
+}
@@ -0,0 +1,341 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Calendar units accepted wherever a timestamp is truncated or rounded.
+// Weeks are ISO weeks starting on Monday unless stated otherwise.
+const (
+	unitSecond  = "second"
+	unitMinute  = "minute"
+	unitHour    = "hour"
+	unitDay     = "day"
+	unitWeek    = "week"
+	unitMonth   = "month"
+	unitQuarter = "quarter"
+	unitYear    = "year"
+)
+
+var calendarUnits = []string{
+	unitSecond,
+	unitMinute,
+	unitHour,
+	unitDay,
+	unitWeek,
+	unitMonth,
+	unitQuarter,
+	unitYear,
+}
+
+// Rounding modes for round_time.
+const (
+	roundNearest = "nearest"
+	roundFloor   = "floor"
+	roundCeil    = "ceil"
+)
+
+var roundingModes = []string{
+	roundNearest,
+	roundFloor,
+	roundCeil,
+}
+
+// wallClock returns the wall clock fields of t in loc as a UTC time, so
+// calendar arithmetic on them is not affected by offset changes.
+func wallClock(t time.Time, loc *time.Location) time.Time {
+	l := t.In(loc)
+	return time.Date(l.Year(), l.Month(), l.Day(), l.Hour(), l.Minute(), l.Second(), l.Nanosecond(), time.UTC)
+}
+
+// startOfUnit truncates wall clock fields to the start of the calendar unit
+// containing them, with weeks starting on weekStart.
+func startOfUnit(wall time.Time, unit string, weekStart time.Weekday) (time.Time, error) {
+	year, month, day := wall.Date()
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	switch unit {
+	case unitSecond:
+		return wall.Truncate(time.Second), nil
+	case unitMinute:
+		return wall.Truncate(time.Minute), nil
+	case unitHour:
+		return wall.Truncate(time.Hour), nil
+	case unitDay:
+		return midnight, nil
+	case unitWeek:
+		return midnight.AddDate(0, 0, -((int(midnight.Weekday()) - int(weekStart) + 7) % 7)), nil
+	case unitMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC), nil
+	case unitQuarter:
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, time.UTC), nil
+	case unitYear:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), nil
+	default:
+		return time.Time{}, fmt.Errorf("unknown unit %q, expected one of: %s", unit, strings.Join(calendarUnits, ", "))
+	}
+}
+
+// addUnits adds n calendar units to wall clock fields that are already at
+// the start of a unit, so adding months never overflows into the next one.
+// Whole days are added separately so that large counts of seconds, minutes
+// or hours do not overflow a duration.
+func addUnits(wall time.Time, unit string, n int) time.Time {
+	switch unit {
+	case unitSecond:
+		return wall.AddDate(0, 0, n/86400).Add(time.Duration(n%86400) * time.Second)
+	case unitMinute:
+		return wall.AddDate(0, 0, n/1440).Add(time.Duration(n%1440) * time.Minute)
+	case unitHour:
+		return wall.AddDate(0, 0, n/24).Add(time.Duration(n%24) * time.Hour)
+	case unitDay:
+		return wall.AddDate(0, 0, n)
+	case unitWeek:
+		return wall.AddDate(0, 0, 7*n)
+	case unitMonth:
+		return wall.AddDate(0, n, 0)
+	case unitQuarter:
+		return wall.AddDate(0, 3*n, 0)
+	default:
+		return wall.AddDate(n, 0, 0)
+	}
+}
+
+// instantAtWallClock returns the instant at which the wall clock in loc
+// shows wall. A wall time skipped by a transition resolves to the first
+// instant after the gap. A repeated wall time resolves to the latest
+// occurrence not after t when notAfter is set, and otherwise to the earliest
+// occurrence not before t, so boundaries stay on the correct side of t.
+func instantAtWallClock(wall time.Time, loc *time.Location, t time.Time, notAfter bool) time.Time {
+	// Neither policy can fail, only unknown policies are rejected.
+	earlier, _ := resolveLocalTime(wall, loc, disambiguationEarlier)
+	later, _ := resolveLocalTime(wall, loc, disambiguationLater)
+
+	if !wallClock(earlier, loc).Equal(wall) {
+		return later
+	}
+
+	if notAfter {
+		if later.After(t) {
+			return earlier
+		}
+		return later
+	}
+
+	if earlier.Before(t) {
+		return later
+	}
+
+	return earlier
+}
+
+// truncateTime returns the start of the calendar unit containing t on the
+// wall clock in loc.
+func truncateTime(t time.Time, unit string, loc *time.Location, weekStart time.Weekday) (time.Time, error) {
+	start, err := startOfUnit(wallClock(t, loc), unit, weekStart)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return instantAtWallClock(start, loc, t, true).In(loc), nil
+}
+
+// roundTime rounds t on the wall clock in loc to a calendar unit or to a
+// duration step, which counts from local midnight and so must divide a day
+// evenly. Nearest rounding rounds halfway instants up.
+func roundTime(t time.Time, step, mode string, loc *time.Location) (time.Time, error) {
+	if !slices.Contains(roundingModes, mode) {
+		return time.Time{}, fmt.Errorf("unknown mode %q, expected one of: %s", mode, strings.Join(roundingModes, ", "))
+	}
+
+	wall := wallClock(t, loc)
+
+	var floor, ceil time.Time
+	if slices.Contains(calendarUnits, step) {
+		var err error
+		if floor, err = startOfUnit(wall, step, time.Monday); err != nil {
+			return time.Time{}, err
+		}
+		ceil = addUnits(floor, step, 1)
+	} else {
+		d, err := time.ParseDuration(step)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("step %q is neither a unit (%s) nor a duration such as \"15m\"", step, strings.Join(calendarUnits, ", "))
+		}
+		if d <= 0 || (24*time.Hour)%d != 0 {
+			return time.Time{}, fmt.Errorf("step %q must be a positive duration that divides a day evenly", step)
+		}
+
+		midnight, _ := startOfUnit(wall, unitDay, time.Monday)
+		floor = midnight.Add(wall.Sub(midnight) / d * d)
+		ceil = floor.Add(d)
+	}
+
+	down := instantAtWallClock(floor, loc, t, true)
+	if down.Equal(t) || mode == roundFloor {
+		return down.In(loc), nil
+	}
+
+	up := instantAtWallClock(ceil, loc, t, false)
+	if mode == roundCeil || up.Sub(t) <= t.Sub(down) {
+		return up.In(loc), nil
+	}
+
+	return down.In(loc), nil
+}
+
+// Fiscal periods are written as the period followed by the month the fiscal
+// year starts in, such as "fiscal_year:april" or "fiscal_quarter:10".
+const (
+	periodFiscalYear    = "fiscal_year"
+	periodFiscalQuarter = "fiscal_quarter"
+)
+
+// calendarPeriod is a calendar unit, or a fiscal year or quarter when
+// fiscalStart is set.
+type calendarPeriod struct {
+	unit        string
+	fiscalStart time.Month
+}
+
+// parsePeriod parses a calendar unit or fiscal period name.
+func parsePeriod(value string) (calendarPeriod, error) {
+	name, month, fiscal := strings.Cut(value, ":")
+
+	switch {
+	case !fiscal && slices.Contains(calendarUnits, name):
+		return calendarPeriod{unit: name}, nil
+	case fiscal && (name == periodFiscalYear || name == periodFiscalQuarter):
+		start, err := parseMonth(month)
+		if err != nil {
+			return calendarPeriod{}, err
+		}
+
+		unit := unitYear
+		if name == periodFiscalQuarter {
+			unit = unitQuarter
+		}
+
+		return calendarPeriod{unit: unit, fiscalStart: start}, nil
+	default:
+		return calendarPeriod{}, fmt.Errorf("unknown period %q, expected one of: %s, or %s:<month> or %s:<month>",
+			value, strings.Join(calendarUnits, ", "), periodFiscalYear, periodFiscalQuarter)
+	}
+}
+
+// start truncates wall clock fields to the start of the period containing
+// them, with weeks starting on weekStart.
+func (p calendarPeriod) start(wall time.Time, weekStart time.Weekday) (time.Time, error) {
+	if p.fiscalStart == 0 {
+		return startOfUnit(wall, p.unit, weekStart)
+	}
+
+	months := 12
+	if p.unit == unitQuarter {
+		months = 3
+	}
+
+	// Count months from the start of year zero, shifted so that fiscal
+	// periods begin on multiples of their length.
+	index := wall.Year()*12 + int(wall.Month()-p.fiscalStart)
+	index -= ((index % months) + months) % months
+	index += int(p.fiscalStart - time.January)
+
+	return time.Date(index/12, time.Month(index%12+1), 1, 0, 0, 0, 0, time.UTC), nil
+}
+
+// bounds returns the first instant of the period containing t on the wall
+// clock in loc, and the first instant of the following period.
+func (p calendarPeriod) bounds(t time.Time, loc *time.Location, weekStart time.Weekday) (time.Time, time.Time, error) {
+	start, err := p.start(wallClock(t, loc), weekStart)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	end := addUnits(start, p.unit, 1)
+
+	return instantAtWallClock(start, loc, t, true).In(loc), instantAtWallClock(end, loc, t, false).In(loc), nil
+}
+
+// calendarDays returns the number of complete calendar days from start to
+// end on the wall clock of start's location, truncated towards zero. Days
+// around a daylight saving transition count as one day even though they
+// are not 24 hours long.
+func calendarDays(start, end time.Time) int {
+	end = end.In(start.Location())
+	if end.Before(start) {
+		return -calendarDays(end, start)
+	}
+
+	startDate, _ := startOfUnit(wallClock(start, start.Location()), unitDay, time.Monday)
+	endDate, _ := startOfUnit(wallClock(end, start.Location()), unitDay, time.Monday)
+
+	days := int(endDate.Sub(startDate) / (24 * time.Hour))
+	if start.AddDate(0, 0, days).After(end) {
+		days--
+	}
+
+	return days
+}
+
+// parseMonth parses a month number from 1 to 12 or an English month name,
+// full or abbreviated to three letters, ignoring case.
+func parseMonth(value string) (time.Month, error) {
+	if n, err := strconv.Atoi(value); err == nil && n >= 1 && n <= 12 {
+		return time.Month(n), nil
+	}
+
+	for m := time.January; m <= time.December; m++ {
+		if strings.EqualFold(value, m.String()) || strings.EqualFold(value, m.String()[:3]) {
+			return m, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown month %q, expected 1 to 12 or a month name", value)
+}
+
+// parseWeekday parses an English weekday name, full or abbreviated to three
+// letters, ignoring case.
+func parseWeekday(value string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(value, d.String()) || strings.EqualFold(value, d.String()[:3]) {
+			return d, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown weekday %q, expected a day name such as \"monday\"", value)
+}
+
+// isoWeekStart returns the Monday starting week of ISO week-numbering year
+// year, as midnight UTC.
+func isoWeekStart(year, week int) (time.Time, error) {
+	if year < 0 || year > 9999 {
+		return time.Time{}, fmt.Errorf("ISO year %d is outside the supported range 0 to 9999", year)
+	}
+
+	// January 4th is always in week 1.
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	start := jan4.AddDate(0, 0, -((int(jan4.Weekday())+6)%7)+7*(week-1))
+
+	if y, w := start.ISOWeek(); week < 1 || y != year || w != week {
+		return time.Time{}, fmt.Errorf("ISO year %d has no week %d", year, week)
+	}
+
+	return start, nil
+}
+
+// weekOfMonth returns the week of the month containing date, where week 1
+// contains the first of the month and later weeks begin on weekStart.
+func weekOfMonth(date time.Time, weekStart time.Weekday) int {
+	first := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	offset := (int(first.Weekday()) - int(weekStart) + 7) % 7
+
+	return (date.Day()-1+offset)/7 + 1
+}
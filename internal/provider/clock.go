@@ -0,0 +1,119 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// fixedNowEnvVar names the environment variable that pins the current time
+// to an RFC3339 timestamp when the provider's fixed_now is not set.
+const fixedNowEnvVar = "TIMEUTILS_FIXED_NOW"
+
+// clock supplies the current time to everything that depends on it, so that
+// tests can pin it.
+type clock interface {
+	Now() time.Time
+}
+
+// systemClock reads the system's wall clock.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// fixedClock always returns the same instant.
+type fixedClock struct {
+	t time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.t
+}
+
+// newClock returns a clock fixed at fixedNow, or at TIMEUTILS_FIXED_NOW when
+// fixedNow is empty, and the system clock when neither is set.
+func newClock(fixedNow string) (clock, error) {
+	source := "fixed_now"
+	if fixedNow == "" {
+		source = fixedNowEnvVar
+		fixedNow = os.Getenv(fixedNowEnvVar)
+	}
+
+	if fixedNow == "" {
+		return systemClock{}, nil
+	}
+
+	t, err := parseTimestamp(fixedNow)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+
+	return fixedClock{t: t}, nil
+}
+
+// environmentClock returns the clock for code that runs before the provider
+// is configured, honouring only TIMEUTILS_FIXED_NOW.
+func environmentClock() (clock, error) {
+	return newClock("")
+}
+
+// providerData is shared by the provider with its resources and data
+// sources.
+type providerData struct {
+	clock clock
+}
+
+// providerClock is embedded in resources and data sources to give them the
+// clock the provider was configured with.
+type providerClock struct {
+	clock clock
+}
+
+// configure stores the clock from the provider data passed to Configure,
+// which is nil until the provider itself has been configured. An invalid
+// TIMEUTILS_FIXED_NOW is reported then too, rather than letting now fall
+// back to the system clock.
+func (p *providerClock) configure(data any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data == nil {
+		if _, err := environmentClock(); err != nil {
+			diags.AddError("Invalid fixed current time", err.Error())
+		}
+		return diags
+	}
+
+	pd, ok := data.(*providerData)
+	if !ok {
+		diags.AddError("Unexpected provider data",
+			fmt.Sprintf("Expected *providerData, got %T. Please report this issue to the provider developers.", data))
+		return diags
+	}
+
+	p.clock = pd.clock
+
+	return diags
+}
+
+// now returns the current time from the configured clock. Before the
+// provider is configured it falls back to TIMEUTILS_FIXED_NOW, which
+// configure and the provider's Configure reject when invalid, and then to
+// the system clock.
+func (p providerClock) now() time.Time {
+	if p.clock != nil {
+		return p.clock.Now()
+	}
+
+	if c, err := environmentClock(); err == nil {
+		return c.Now()
+	}
+
+	return time.Now()
+}
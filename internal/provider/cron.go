@@ -0,0 +1,288 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronField describes one field of a five-field cron expression.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"", "jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	// Day of week 7 is accepted as a second name for Sunday.
+	{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+// cronMacros are the predefined schedules accepted in place of five fields.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronSearchDays bounds the search for a matching day. Eight years and a day
+// covers the longest gap between matches of any satisfiable expression,
+// 29 February across a century year that is not a leap year.
+const cronSearchDays = 8*366 + 1
+
+// cronSchedule is a parsed five-field cron expression matched against wall
+// clock times at minute resolution. Each field is a bit set of the values
+// it matches.
+type cronSchedule struct {
+	minutes  uint64
+	hours    uint64
+	days     uint64
+	months   uint64
+	weekdays uint64
+	// Like Vixie cron, when both the day of month and day of week are
+	// restricted, a day matching either of them matches.
+	daysRestricted     bool
+	weekdaysRestricted bool
+}
+
+// parseCron parses a five-field cron expression (minute, hour, day of month,
+// month and day of week) or one of the @ macros such as @daily. Fields
+// accept *, numbers, ranges, lists and /steps, and months and days of week
+// accept English names abbreviated to three letters.
+func parseCron(expr string) (cronSchedule, error) {
+	if macro, ok := cronMacros[strings.ToLower(strings.TrimSpace(expr))]; ok {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return cronSchedule{}, fmt.Errorf("%q must have 5 fields (minute, hour, day of month, month, day of week), got %d", expr, len(fields))
+	}
+
+	sets := make([]uint64, len(fields))
+	for i, value := range fields {
+		set, err := parseCronField(value, cronFields[i])
+		if err != nil {
+			return cronSchedule{}, fmt.Errorf("%s field: %w", cronFields[i].name, err)
+		}
+		sets[i] = set
+	}
+
+	// Fold Sunday written as 7 onto 0.
+	weekdays := sets[4]
+	if weekdays&(1<<7) != 0 {
+		weekdays = weekdays&^(1<<7) | 1
+	}
+
+	return cronSchedule{
+		minutes:            sets[0],
+		hours:              sets[1],
+		days:               sets[2],
+		months:             sets[3],
+		weekdays:           weekdays,
+		daysRestricted:     !strings.HasPrefix(fields[2], "*"),
+		weekdaysRestricted: !strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseCronField parses a comma-separated list of cron values, ranges and
+// steps into a bit set.
+func parseCronField(value string, field cronField) (uint64, error) {
+	var set uint64
+
+	for _, part := range strings.Split(value, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n < 1 || n > field.max-field.min {
+				return 0, fmt.Errorf("step %q must be a positive whole number no larger than %d", stepPart, field.max-field.min)
+			}
+			step = n
+		}
+
+		var low, high int
+		switch {
+		case rangePart == "*":
+			low, high = field.min, field.max
+			if field.max == 7 {
+				// Sunday is already matched by 0.
+				high = 6
+			}
+		case strings.Contains(rangePart, "-"):
+			lowPart, highPart, _ := strings.Cut(rangePart, "-")
+			var err error
+			if low, err = parseCronValue(lowPart, field); err != nil {
+				return 0, err
+			}
+			if high, err = parseCronValue(highPart, field); err != nil {
+				return 0, err
+			}
+			if high < low {
+				return 0, fmt.Errorf("range %q ends before it starts", rangePart)
+			}
+		default:
+			var err error
+			if low, err = parseCronValue(rangePart, field); err != nil {
+				return 0, err
+			}
+			high = low
+			if hasStep {
+				high = field.max
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			set |= 1 << v
+		}
+	}
+
+	return set, nil
+}
+
+// parseCronValue parses a single number or name within a cron field.
+func parseCronValue(value string, field cronField) (int, error) {
+	for i, name := range field.names {
+		if name != "" && strings.EqualFold(value, name) {
+			return i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < field.min || n > field.max {
+		return 0, fmt.Errorf("%q is not a %s from %d to %d", value, field.name, field.min, field.max)
+	}
+
+	return n, nil
+}
+
+// dayMatches reports whether the schedule fires on the date of wall.
+func (c cronSchedule) dayMatches(wall time.Time) bool {
+	if c.months&(1<<int(wall.Month())) == 0 {
+		return false
+	}
+
+	day := c.days&(1<<wall.Day()) != 0
+	weekday := c.weekdays&(1<<int(wall.Weekday())) != 0
+
+	if c.daysRestricted && c.weekdaysRestricted {
+		return day || weekday
+	}
+
+	return day && weekday
+}
+
+// nextWall returns the first wall clock time strictly after wall at which
+// the schedule fires.
+func (c cronSchedule) nextWall(wall time.Time) (time.Time, error) {
+	start := wall.Truncate(time.Minute).Add(time.Minute)
+	date := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < cronSearchDays; i++ {
+		if c.dayMatches(date) {
+			for hour := 0; hour < 24; hour++ {
+				if c.hours&(1<<hour) == 0 {
+					continue
+				}
+				for minute := 0; minute < 60; minute++ {
+					candidate := date.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+					if c.minutes&(1<<minute) != 0 && !candidate.Before(start) {
+						return candidate, nil
+					}
+				}
+			}
+		}
+		date = date.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}, errors.New("the schedule never fires")
+}
+
+// previousWall returns the last wall clock time at or before wall at which
+// the schedule fires.
+func (c cronSchedule) previousWall(wall time.Time) (time.Time, error) {
+	end := wall.Truncate(time.Minute)
+	date := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < cronSearchDays; i++ {
+		if c.dayMatches(date) {
+			for hour := 23; hour >= 0; hour-- {
+				if c.hours&(1<<hour) == 0 {
+					continue
+				}
+				for minute := 59; minute >= 0; minute-- {
+					candidate := date.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+					if c.minutes&(1<<minute) != 0 && !candidate.After(end) {
+						return candidate, nil
+					}
+				}
+			}
+		}
+		date = date.AddDate(0, 0, -1)
+	}
+
+	return time.Time{}, errors.New("the schedule never fires")
+}
+
+// next returns the first instant after t at which the schedule fires on the
+// wall clock of loc. A wall time skipped by a daylight saving transition
+// fires shifted forward by the length of the gap, and a repeated one fires
+// once, at its earlier occurrence.
+func (c cronSchedule) next(t time.Time, loc *time.Location) (time.Time, error) {
+	wall := wallClock(t, loc)
+
+	for {
+		var err error
+		if wall, err = c.nextWall(wall); err != nil {
+			return time.Time{}, err
+		}
+
+		instant, err := resolveLocalTime(wall, loc, disambiguationCompatible)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if instant.After(t) {
+			return instant.In(loc), nil
+		}
+	}
+}
+
+// previous returns the last instant at or before t at which the schedule
+// fires on the wall clock of loc, resolving wall times as next does.
+func (c cronSchedule) previous(t time.Time, loc *time.Location) (time.Time, error) {
+	wall := wallClock(t, loc)
+
+	for {
+		var err error
+		if wall, err = c.previousWall(wall); err != nil {
+			return time.Time{}, err
+		}
+
+		instant, err := resolveLocalTime(wall, loc, disambiguationCompatible)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if !instant.After(t) {
+			return instant.In(loc), nil
+		}
+
+		wall = wall.Add(-time.Minute)
+	}
+}
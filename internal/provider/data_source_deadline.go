@@ -0,0 +1,196 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultDeadlineName labels the deadline in diagnostics when name is not
+// configured.
+const defaultDeadlineName = "Deadline"
+
+var (
+	_ datasource.DataSource              = &DeadlineDataSource{}
+	_ datasource.DataSourceWithConfigure = &DeadlineDataSource{}
+)
+
+type DeadlineDataSource struct {
+	providerClock
+}
+
+type deadlineDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Timestamp        types.String `tfsdk:"timestamp"`
+	WarnWithin       types.String `tfsdk:"warn_within"`
+	ErrorWithin      types.String `tfsdk:"error_within"`
+	Reference        types.String `tfsdk:"reference"`
+	ReferenceTime    types.String `tfsdk:"reference_time"`
+	RemainingSeconds types.Int64  `tfsdk:"remaining_seconds"`
+	Remaining        types.String `tfsdk:"remaining"`
+	Expired          types.Bool   `tfsdk:"expired"`
+	WithinWarning    types.Bool   `tfsdk:"within_warning"`
+	WithinError      types.Bool   `tfsdk:"within_error"`
+}
+
+func NewDeadlineDataSource() datasource.DataSource {
+	return &DeadlineDataSource{}
+}
+
+func (d *DeadlineDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deadline"
+}
+
+func (d *DeadlineDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(d.configure(req.ProviderData)...)
+}
+
+func (d *DeadlineDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks how long remains until a deadline such as a certificate, token or reserved instance expiry. " +
+			"When the deadline is within warn_within of the reference time, reading the data source emits a warning diagnostic, and within error_within an error that fails the plan, each stating the exact time remaining. " +
+			"Thresholds may be a Go duration ('720h'), an ISO 8601 duration ('P1M') or a calendar unit with an optional count ('30 days'); calendar parts are added on the wall clock of the reference's offset.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The deadline, formatted as RFC3339.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Label for the deadline in diagnostics (e.g., 'Reserved instance ri-0abc'). Defaults to 'Deadline'.",
+				Optional:    true,
+			},
+			"timestamp": schema.StringAttribute{
+				Description: "The deadline, " + timestampFormatSummary + "; dates without a time are midnight UTC.",
+				Required:    true,
+			},
+			"warn_within": schema.StringAttribute{
+				Description: "Emit a warning when the deadline is at or before the reference time plus this duration.",
+				Required:    true,
+			},
+			"error_within": schema.StringAttribute{
+				Description: "Emit an error when the deadline is at or before the reference time plus this duration. Use '0s' to fail only once the deadline has passed.",
+				Optional:    true,
+			},
+			"reference": schema.StringAttribute{
+				Description: "Time to measure the remaining time from, in the same formats as timestamp. Defaults to the current time.",
+				Optional:    true,
+			},
+			"reference_time": schema.StringAttribute{
+				Description: "The time the remaining time was measured from, formatted as RFC3339: reference when set, otherwise the current time.",
+				Computed:    true,
+			},
+			"remaining_seconds": schema.Int64Attribute{
+				Description: "Whole seconds from reference to the deadline, negative once it has passed.",
+				Computed:    true,
+			},
+			"remaining": schema.StringAttribute{
+				Description: "The time from reference to the deadline in words (e.g., '12 days, 3 hours and 5 minutes'), without a sign.",
+				Computed:    true,
+			},
+			"expired": schema.BoolAttribute{
+				Description: "Whether the deadline is at or before reference.",
+				Computed:    true,
+			},
+			"within_warning": schema.BoolAttribute{
+				Description: "Whether the deadline is within warn_within of reference.",
+				Computed:    true,
+			},
+			"within_error": schema.BoolAttribute{
+				Description: "Whether the deadline is within error_within of reference. False when error_within is not set.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *DeadlineDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data deadlineDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deadline, err := parseAnyTimestamp(data.Timestamp.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timestamp"), "Invalid timestamp", err.Error())
+		return
+	}
+
+	warnWithin, err := parseCalendarStep(data.WarnWithin.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("warn_within"), "Invalid duration", err.Error())
+		return
+	}
+
+	var errorWithin *calendarStep
+	if !data.ErrorWithin.IsNull() {
+		step, err := parseZeroableStep(data.ErrorWithin.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("error_within"), "Invalid duration", err.Error())
+			return
+		}
+		errorWithin = &step
+	}
+
+	reference := d.now().UTC()
+	if !data.Reference.IsNull() {
+		if reference, err = parseAnyTimestamp(data.Reference.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("reference"), "Invalid reference timestamp", err.Error())
+			return
+		}
+	}
+
+	name := defaultDeadlineName
+	if !data.Name.IsNull() && data.Name.ValueString() != "" {
+		name = data.Name.ValueString()
+	}
+
+	remaining := secondsBetween(reference, deadline)
+	expired := !deadline.After(reference)
+	withinWarning := expiresWithin(deadline, reference, warnWithin)
+	withinError := errorWithin != nil && expiresWithin(deadline, reference, *errorWithin)
+
+	data.ID = types.StringValue(formatTimestamp(deadline))
+	data.ReferenceTime = types.StringValue(formatTimestamp(reference))
+	data.RemainingSeconds = types.Int64Value(remaining)
+	data.Remaining = types.StringValue(humanizeSeconds(remaining))
+	data.Expired = types.BoolValue(expired)
+	data.WithinWarning = types.BoolValue(withinWarning)
+	data.WithinError = types.BoolValue(withinError)
+
+	summary := fmt.Sprintf("%s expires in %s", name, humanizeSeconds(remaining))
+	if expired {
+		summary = fmt.Sprintf("%s expired %s ago", name, humanizeSeconds(remaining))
+	}
+
+	switch {
+	case withinError:
+		resp.Diagnostics.AddAttributeError(path.Root("timestamp"), summary,
+			fmt.Sprintf("%s at %s is within error_within (%s) of %s.", name, formatTimestamp(deadline), data.ErrorWithin.ValueString(), formatTimestamp(reference)))
+	case withinWarning:
+		resp.Diagnostics.AddAttributeWarning(path.Root("timestamp"), summary,
+			fmt.Sprintf("%s at %s is within warn_within (%s) of %s.", name, formatTimestamp(deadline), data.WarnWithin.ValueString(), formatTimestamp(reference)))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseZeroableStep parses a duration like parseCalendarStep, also accepting
+// a zero duration such as "0s".
+func parseZeroableStep(value string) (calendarStep, error) {
+	if d, err := time.ParseDuration(value); err == nil && d == 0 {
+		return calendarStep{}, nil
+	}
+
+	return parseCalendarStep(value)
+}
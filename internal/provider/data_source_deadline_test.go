@@ -0,0 +1,228 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDeadlineDataSource(t *testing.T) {
+	testCases := []struct {
+		name              string
+		config            map[string]string
+		expectedSeverity  diag.Severity
+		expectedSummary   string
+		expectedRemaining string
+		expectedSeconds   int64
+		expectedExpired   bool
+	}{
+		{
+			name: "outside the thresholds",
+			config: map[string]string{
+				"timestamp":    "2024-06-30T00:00:00Z",
+				"warn_within":  "30 days",
+				"error_within": "7 days",
+				"reference":    "2024-01-15T10:30:00Z",
+			},
+			expectedRemaining: "166 days, 13 hours and 30 minutes",
+			expectedSeconds:   14391000,
+		},
+		{
+			name: "within the warning threshold",
+			config: map[string]string{
+				"name":         "Reserved instance ri-0abc",
+				"timestamp":    "2024-02-01T00:00:00Z",
+				"warn_within":  "30 days",
+				"error_within": "7 days",
+				"reference":    "2024-01-15T10:29:30Z",
+			},
+			expectedSeverity:  diag.SeverityWarning,
+			expectedSummary:   "Reserved instance ri-0abc expires in 16 days, 13 hours, 30 minutes and 30 seconds",
+			expectedRemaining: "16 days, 13 hours, 30 minutes and 30 seconds",
+			expectedSeconds:   1431030,
+		},
+		{
+			name: "within the error threshold",
+			config: map[string]string{
+				"timestamp":    "2024-01-16T10:30:00.5+01:00",
+				"warn_within":  "P1M",
+				"error_within": "P7D",
+				"reference":    "2024-01-15T10:30:00+01:00",
+			},
+			expectedSeverity:  diag.SeverityError,
+			expectedSummary:   "Deadline expires in 1 day",
+			expectedRemaining: "1 day",
+			expectedSeconds:   86400,
+		},
+		{
+			name: "expired without an error threshold warns",
+			config: map[string]string{
+				"name":        "API token",
+				"timestamp":   "2024-01-14",
+				"warn_within": "14 days",
+				"reference":   "2024-01-15T01:00:01Z",
+			},
+			expectedSeverity:  diag.SeverityWarning,
+			expectedSummary:   "API token expired 1 day, 1 hour and 1 second ago",
+			expectedRemaining: "1 day, 1 hour and 1 second",
+			expectedSeconds:   -90001,
+			expectedExpired:   true,
+		},
+		{
+			name: "sub-second remainder rounds down once expired",
+			config: map[string]string{
+				"timestamp":   "2024-01-15T10:29:59.5Z",
+				"warn_within": "1h",
+				"reference":   "2024-01-15T10:30:00Z",
+			},
+			expectedSeverity:  diag.SeverityWarning,
+			expectedSummary:   "Deadline expired 1 second ago",
+			expectedRemaining: "1 second",
+			expectedSeconds:   -1,
+			expectedExpired:   true,
+		},
+		{
+			name: "deadline beyond the range of a duration",
+			config: map[string]string{
+				"timestamp":   "9999-12-31T23:59:59Z",
+				"warn_within": "30 days",
+				"reference":   "2024-01-01",
+			},
+			expectedRemaining: "2913173 days, 23 hours, 59 minutes and 59 seconds",
+			expectedSeconds:   251698233599,
+		},
+		{
+			name: "expired with a zero error threshold fails",
+			config: map[string]string{
+				"timestamp":    "2024-01-15T10:30:00Z",
+				"warn_within":  "1h",
+				"error_within": "0s",
+				"reference":    "2024-01-15T10:30:00Z",
+			},
+			expectedSeverity:  diag.SeverityError,
+			expectedSummary:   "Deadline expired 0 seconds ago",
+			expectedRemaining: "0 seconds",
+			expectedExpired:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]tftypes.Value{}
+			for name, value := range tc.config {
+				config[name] = tftypes.NewValue(tftypes.String, value)
+			}
+
+			state, diags := readDataSource(t, NewDeadlineDataSource(), config)
+
+			if tc.expectedSummary == "" {
+				if len(diags) != 0 {
+					t.Errorf("Unexpected diagnostics: %v", diags)
+				}
+			} else if len(diags) != 1 || diags[0].Severity() != tc.expectedSeverity || diags[0].Summary() != tc.expectedSummary {
+				t.Errorf("Expected a single %v diagnostic %q, got %v", tc.expectedSeverity, tc.expectedSummary, diags)
+			}
+
+			var data deadlineDataSourceModel
+			if diags := state.Get(context.Background(), &data); diags.HasError() {
+				t.Fatalf("Failed to read state: %v", diags)
+			}
+
+			if data.Remaining.ValueString() != tc.expectedRemaining {
+				t.Errorf("Expected remaining %q, got %q", tc.expectedRemaining, data.Remaining.ValueString())
+			}
+
+			if data.RemainingSeconds.ValueInt64() != tc.expectedSeconds {
+				t.Errorf("Expected remaining_seconds %d, got %d", tc.expectedSeconds, data.RemainingSeconds.ValueInt64())
+			}
+
+			if data.Expired.ValueBool() != tc.expectedExpired {
+				t.Errorf("Expected expired %v, got %v", tc.expectedExpired, data.Expired.ValueBool())
+			}
+
+			if data.WithinError.ValueBool() != (tc.expectedSeverity == diag.SeverityError) {
+				t.Errorf("Unexpected within_error %v", data.WithinError.ValueBool())
+			}
+
+			if data.WithinWarning.ValueBool() != (tc.expectedSeverity != diag.SeverityInvalid) {
+				t.Errorf("Unexpected within_warning %v", data.WithinWarning.ValueBool())
+			}
+		})
+	}
+}
+
+func TestDeadlineDataSourceDefaultsToNow(t *testing.T) {
+	t.Setenv(fixedNowEnvVar, "2024-01-15T10:30:00Z")
+
+	state, diags := readDataSource(t, NewDeadlineDataSource(), map[string]tftypes.Value{
+		"timestamp":   tftypes.NewValue(tftypes.String, "2024-01-15T12:00:00Z"),
+		"warn_within": tftypes.NewValue(tftypes.String, "1h"),
+	})
+	if len(diags) != 0 {
+		t.Errorf("Unexpected diagnostics: %v", diags)
+	}
+
+	if got := stateString(t, state, "reference_time"); got != "2024-01-15T10:30:00Z" {
+		t.Errorf("Expected the current time as reference_time, got %q", got)
+	}
+
+	if got := stateString(t, state, "remaining"); got != "1 hour and 30 minutes" {
+		t.Errorf("Expected remaining %q, got %q", "1 hour and 30 minutes", got)
+	}
+}
+
+func TestDeadlineDataSourceKeepsReference(t *testing.T) {
+	for reference, expected := range map[string]string{
+		"2024-01-15":                "2024-01-15T00:00:00Z",
+		"1705314600":                "2024-01-15T10:30:00Z",
+		"2024-01-15T12:30:00+02:00": "2024-01-15T12:30:00+02:00",
+	} {
+		state, diags := readDataSource(t, NewDeadlineDataSource(), map[string]tftypes.Value{
+			"timestamp":   tftypes.NewValue(tftypes.String, "2025-01-15"),
+			"warn_within": tftypes.NewValue(tftypes.String, "1h"),
+			"reference":   tftypes.NewValue(tftypes.String, reference),
+		})
+		if len(diags) != 0 {
+			t.Errorf("Unexpected diagnostics: %v", diags)
+		}
+
+		if got := stateString(t, state, "reference"); got != reference {
+			t.Errorf("Expected reference %q to be kept, got %q", reference, got)
+		}
+
+		if got := stateString(t, state, "reference_time"); got != expected {
+			t.Errorf("Expected reference_time %q for %q, got %q", expected, reference, got)
+		}
+	}
+}
+
+func TestDeadlineDataSourceInvalid(t *testing.T) {
+	for name, config := range map[string]map[string]string{
+		"timestamp":    {"timestamp": "soon", "warn_within": "1h"},
+		"warn_within":  {"timestamp": "2024-01-15", "warn_within": "0s"},
+		"error_within": {"timestamp": "2024-01-15", "warn_within": "1h", "error_within": "-1h"},
+		"reference":    {"timestamp": "2024-01-15", "warn_within": "1h", "reference": "today"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for attr, value := range config {
+				values[attr] = tftypes.NewValue(tftypes.String, value)
+			}
+
+			_, diags := readDataSource(t, NewDeadlineDataSource(), values)
+			if !diags.HasError() {
+				t.Fatalf("Expected error, but got none")
+			}
+
+			if got := diags[0].(interface{ Path() path.Path }).Path().String(); got != name {
+				t.Errorf("Expected error at %s, got %s", name, got)
+			}
+		})
+	}
+}
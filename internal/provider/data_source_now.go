@@ -0,0 +1,181 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                   = &NowDataSource{}
+	_ datasource.DataSourceWithConfigure      = &NowDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &NowDataSource{}
+)
+
+type NowDataSource struct {
+	providerClock
+}
+
+type nowDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Timezone   types.String `tfsdk:"timezone"`
+	Zones      types.List   `tfsdk:"zones"`
+	Formats    types.Map    `tfsdk:"formats"`
+	RFC3339    types.String `tfsdk:"rfc3339"`
+	LocalTime  types.String `tfsdk:"local_time"`
+	Unix       types.Int64  `tfsdk:"unix"`
+	UnixMs     types.Int64  `tfsdk:"unix_ms"`
+	Formatted  types.Map    `tfsdk:"formatted"`
+	LocalTimes types.Map    `tfsdk:"local_times"`
+}
+
+func NewNowDataSource() datasource.DataSource {
+	return &NowDataSource{}
+}
+
+func (d *NowDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_now"
+}
+
+func (d *NowDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(d.configure(req.ProviderData)...)
+}
+
+func (d *NowDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the current time in several formats and time zones. " +
+			"Unlike Terraform's timestamp(), the time can be pinned with the provider's fixed_now attribute or the " + fixedNowEnvVar + " environment variable for stable test output.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The current time, formatted as RFC3339 in UTC.",
+				Computed:    true,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA time zone for local_time and formatted. Defaults to UTC.",
+				Optional:    true,
+			},
+			"zones": schema.ListAttribute{
+				Description: "IANA time zone names to convert the current time into in local_times.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"formats": schema.MapAttribute{
+				Description: "Map of names to strftime format strings (e.g., { date = \"%Y-%m-%d\" }) to render the current time in timezone with in formatted.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"rfc3339": schema.StringAttribute{
+				Description: "The current time, formatted as RFC3339 in UTC.",
+				Computed:    true,
+			},
+			"local_time": schema.StringAttribute{
+				Description: "The current time in timezone, formatted as RFC3339.",
+				Computed:    true,
+			},
+			"unix": schema.Int64Attribute{
+				Description: "Number of seconds since the Unix epoch.",
+				Computed:    true,
+			},
+			"unix_ms": schema.Int64Attribute{
+				Description: "Number of milliseconds since the Unix epoch.",
+				Computed:    true,
+			},
+			"formatted": schema.MapAttribute{
+				Description: "Map of the names in formats to the current time in timezone rendered with each format.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"local_times": schema.MapNestedAttribute{
+				Description: "Map of the names in zones to the current time on that zone's wall clock.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rfc3339": schema.StringAttribute{
+							Description: "The current time in the time zone, formatted as RFC3339.",
+							Computed:    true,
+						},
+						"offset": schema.StringAttribute{
+							Description: "UTC offset currently in effect (e.g., '+01:00').",
+							Computed:    true,
+						},
+						"abbreviation": schema.StringAttribute{
+							Description: "Time zone abbreviation currently in effect (e.g., 'CET'). Zones without an abbreviation report the numeric offset.",
+							Computed:    true,
+						},
+						"is_dst": schema.BoolAttribute{
+							Description: "Whether daylight saving time is currently in effect.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NowDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data nowDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Timezone.IsNull() && !data.Timezone.IsUnknown() {
+		if _, err := loadLocation(data.Timezone.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timezone"), "Invalid time zone", err.Error())
+		}
+	}
+
+	resp.Diagnostics.Append(validateZones(data.Zones)...)
+	resp.Diagnostics.Append(validateFormats(ctx, data.Formats)...)
+}
+
+func (d *NowDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data nowDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loc := time.UTC
+	if !data.Timezone.IsNull() {
+		var err error
+		if loc, err = loadLocation(data.Timezone.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timezone"), "Invalid time zone", err.Error())
+			return
+		}
+	}
+
+	now := d.now()
+	local := now.In(loc)
+
+	var diags diag.Diagnostics
+
+	data.Formatted, diags = renderFormats(ctx, data.Formats, local)
+	resp.Diagnostics.Append(diags...)
+
+	data.LocalTimes, diags = zoneTimes(ctx, data.Zones, now)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(formatTimestamp(now.UTC()))
+	data.RFC3339 = types.StringValue(formatTimestamp(now.UTC()))
+	data.LocalTime = types.StringValue(formatTimestamp(local))
+	data.Unix = types.Int64Value(now.Unix())
+	data.UnixMs = types.Int64Value(now.UnixMilli())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,230 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNowDataSource(t *testing.T) {
+	t.Setenv(fixedNowEnvVar, "2024-03-31T00:30:00.25Z")
+
+	state, diags := readDataSource(t, NewNowDataSource(), map[string]tftypes.Value{
+		"timezone": tftypes.NewValue(tftypes.String, "America/Sao_Paulo"),
+		"zones": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "Europe/Berlin"),
+			tftypes.NewValue(tftypes.String, "Asia/Tokyo"),
+		}),
+		"formats": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"date": tftypes.NewValue(tftypes.String, "%Y-%m-%d"),
+			"time": tftypes.NewValue(tftypes.String, "%H:%M"),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	var data nowDataSourceModel
+	if diags := state.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to read state: %v", diags)
+	}
+
+	for name, tc := range map[string]struct {
+		got  string
+		want string
+	}{
+		"id":         {data.ID.ValueString(), "2024-03-31T00:30:00.25Z"},
+		"rfc3339":    {data.RFC3339.ValueString(), "2024-03-31T00:30:00.25Z"},
+		"local_time": {data.LocalTime.ValueString(), "2024-03-30T21:30:00.25-03:00"},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("Expected %s %q, got %q", name, tc.want, tc.got)
+		}
+	}
+
+	if data.Unix.ValueInt64() != 1711845000 || data.UnixMs.ValueInt64() != 1711845000250 {
+		t.Errorf("Unexpected Unix times %d and %d", data.Unix.ValueInt64(), data.UnixMs.ValueInt64())
+	}
+
+	formatted := map[string]string{}
+	if diags := data.Formatted.ElementsAs(context.Background(), &formatted, false); diags.HasError() {
+		t.Fatalf("Failed to read formatted: %v", diags)
+	}
+
+	if formatted["date"] != "2024-03-30" || formatted["time"] != "21:30" {
+		t.Errorf("Expected formats rendered in timezone, got %v", formatted)
+	}
+
+	localTimes := map[string]struct {
+		RFC3339      types.String `tfsdk:"rfc3339"`
+		Offset       types.String `tfsdk:"offset"`
+		Abbreviation types.String `tfsdk:"abbreviation"`
+		IsDST        types.Bool   `tfsdk:"is_dst"`
+	}{}
+	if diags := data.LocalTimes.ElementsAs(context.Background(), &localTimes, false); diags.HasError() {
+		t.Fatalf("Failed to read local_times: %v", diags)
+	}
+
+	// Berlin switches to summer time half an hour later, at 01:00 UTC.
+	if got := localTimes["Europe/Berlin"]; got.RFC3339.ValueString() != "2024-03-31T01:30:00.25+01:00" || got.Abbreviation.ValueString() != "CET" || got.IsDST.ValueBool() {
+		t.Errorf("Unexpected local time in Europe/Berlin: %v", got)
+	}
+
+	if got := localTimes["Asia/Tokyo"]; got.RFC3339.ValueString() != "2024-03-31T09:30:00.25+09:00" || got.Offset.ValueString() != "+09:00" {
+		t.Errorf("Unexpected local time in Asia/Tokyo: %v", got)
+	}
+}
+
+func TestNowDataSourceClock(t *testing.T) {
+	t.Setenv(fixedNowEnvVar, "")
+
+	before := time.Now()
+
+	state, diags := readDataSource(t, NewNowDataSource(), map[string]tftypes.Value{})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	got, err := parseTimestamp(stateString(t, state, "rfc3339"))
+	if err != nil {
+		t.Fatalf("Expected an RFC3339 timestamp: %v", err)
+	}
+
+	if got.Before(before) || got.After(time.Now()) {
+		t.Errorf("Expected the system time, got %s", got)
+	}
+
+	// The provider's clock takes precedence over the environment.
+	t.Setenv(fixedNowEnvVar, "2030-01-01T00:00:00Z")
+
+	d := NewNowDataSource()
+
+	configureResp := &datasource.ConfigureResponse{}
+	d.(datasource.DataSourceWithConfigure).Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: &providerData{clock: fixedClock{t: time.Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC)}},
+	}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	state, diags = readDataSource(t, d, map[string]tftypes.Value{})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if got := stateString(t, state, "rfc3339"); got != "2024-02-29T12:00:00Z" {
+		t.Errorf("Expected the provider's fixed time, got %q", got)
+	}
+}
+
+func TestNowDataSourceConfigureInvalidEnvironment(t *testing.T) {
+	t.Setenv(fixedNowEnvVar, "2024-02-30T00:00:00Z")
+
+	resp := &datasource.ConfigureResponse{}
+	NewNowDataSource().(datasource.DataSourceWithConfigure).Configure(context.Background(), datasource.ConfigureRequest{}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("Expected an error for an invalid %s, but got none", fixedNowEnvVar)
+	}
+
+	if detail := resp.Diagnostics[0].Detail(); !strings.Contains(detail, fixedNowEnvVar) {
+		t.Errorf("Expected the error to name %s, got %q", fixedNowEnvVar, detail)
+	}
+}
+
+func TestNowDataSourceValidateConfig(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     map[string]tftypes.Value
+		expectPath string
+	}{
+		{
+			name:   "empty",
+			config: map[string]tftypes.Value{},
+		},
+		{
+			name: "invalid timezone",
+			config: map[string]tftypes.Value{
+				"timezone": tftypes.NewValue(tftypes.String, "Local"),
+			},
+			expectPath: "timezone",
+		},
+		{
+			name: "unknown zone",
+			config: map[string]tftypes.Value{
+				"zones": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "Europe/Atlantis"),
+				}),
+			},
+			expectPath: "zones[0]",
+		},
+		{
+			name: "invalid format",
+			config: map[string]tftypes.Value{
+				"formats": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"bad": tftypes.NewValue(tftypes.String, "%Q"),
+				}),
+			},
+			expectPath: `formats["bad"]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			d := NewNowDataSource().(datasource.DataSourceWithValidateConfig)
+
+			schemaResp := &datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+			objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			if !ok {
+				t.Fatalf("Expected schema to be an object type")
+			}
+
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attrType := range objectType.AttributeTypes {
+				if v, ok := tc.config[name]; ok {
+					values[name] = v
+				} else {
+					values[name] = tftypes.NewValue(attrType, nil)
+				}
+			}
+
+			resp := &datasource.ValidateConfigResponse{}
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+
+			if tc.expectPath == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("Unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("Expected error, but got none")
+			}
+
+			withPath, ok := resp.Diagnostics[0].(interface{ Path() path.Path })
+			if !ok || withPath.Path().String() != tc.expectPath {
+				t.Errorf("Expected error at %s, got %v", tc.expectPath, resp.Diagnostics[0])
+			}
+
+			if strings.TrimSpace(resp.Diagnostics[0].Detail()) == "" {
+				t.Errorf("Expected error detail, got none")
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// defaultTransitionCount is the number of upcoming transitions returned
+	// when transition_count is not configured.
+	defaultTransitionCount = 5
+	// maxTransitionCount bounds transition_count to keep state small.
+	maxTransitionCount = 1000
+)
+
+var (
+	_ datasource.DataSource              = &TimezoneDataSource{}
+	_ datasource.DataSourceWithConfigure = &TimezoneDataSource{}
+)
+
+type TimezoneDataSource struct {
+	providerClock
+}
+
+type timezoneDataSourceModel struct {
+	ID              types.String              `tfsdk:"id"`
+	Name            types.String              `tfsdk:"name"`
+	Timestamp       types.String              `tfsdk:"timestamp"`
+	ReferenceTime   types.String              `tfsdk:"reference_time"`
+	TransitionCount types.Int64               `tfsdk:"transition_count"`
+	LocalTime       types.String              `tfsdk:"local_time"`
+	Offset          types.String              `tfsdk:"offset"`
+	OffsetSeconds   types.Int64               `tfsdk:"offset_seconds"`
+	Abbreviation    types.String              `tfsdk:"abbreviation"`
+	IsDST           types.Bool                `tfsdk:"is_dst"`
+	Transitions     []timezoneTransitionModel `tfsdk:"transitions"`
+}
+
+type timezoneTransitionModel struct {
+	Timestamp     types.String `tfsdk:"timestamp"`
+	LocalTime     types.String `tfsdk:"local_time"`
+	Offset        types.String `tfsdk:"offset"`
+	OffsetSeconds types.Int64  `tfsdk:"offset_seconds"`
+	Abbreviation  types.String `tfsdk:"abbreviation"`
+	IsDST         types.Bool   `tfsdk:"is_dst"`
+}
+
+func NewTimezoneDataSource() datasource.DataSource {
+	return &TimezoneDataSource{}
+}
+
+func (d *TimezoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_timezone"
+}
+
+func (d *TimezoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(d.configure(req.ProviderData)...)
+}
+
+func (d *TimezoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a time zone's UTC offset, abbreviation and daylight saving status at a reference timestamp, along with its upcoming offset transitions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The time zone name and reference timestamp.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "IANA time zone name (e.g., 'Europe/Berlin').",
+				Required:    true,
+			},
+			"timestamp": schema.StringAttribute{
+				Description: "RFC3339 formatted reference timestamp. Defaults to the current time.",
+				Optional:    true,
+			},
+			"reference_time": schema.StringAttribute{
+				Description: "The time the zone was looked up at, formatted as RFC3339: timestamp when set, otherwise the current time.",
+				Computed:    true,
+			},
+			"transition_count": schema.Int64Attribute{
+				Description: "Number of upcoming transitions to return, between 0 and 1000. Defaults to 5.",
+				Optional:    true,
+			},
+			"local_time": schema.StringAttribute{
+				Description: "The reference timestamp in the time zone, formatted as RFC3339.",
+				Computed:    true,
+			},
+			"offset": schema.StringAttribute{
+				Description: "UTC offset in effect at the reference timestamp (e.g., '+01:00').",
+				Computed:    true,
+			},
+			"offset_seconds": schema.Int64Attribute{
+				Description: "UTC offset in effect at the reference timestamp, in seconds.",
+				Computed:    true,
+			},
+			"abbreviation": schema.StringAttribute{
+				Description: "Time zone abbreviation in effect at the reference timestamp (e.g., 'CET'). Zones without an abbreviation report the numeric offset.",
+				Computed:    true,
+			},
+			"is_dst": schema.BoolAttribute{
+				Description: "Whether daylight saving time is in effect at the reference timestamp.",
+				Computed:    true,
+			},
+			"transitions": schema.ListNestedAttribute{
+				Description: "Upcoming offset transitions after the reference timestamp, in chronological order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Description: "Instant of the transition, formatted as RFC3339 in UTC.",
+							Computed:    true,
+						},
+						"local_time": schema.StringAttribute{
+							Description: "Instant of the transition in the time zone, formatted as RFC3339.",
+							Computed:    true,
+						},
+						"offset": schema.StringAttribute{
+							Description: "UTC offset in effect from the transition.",
+							Computed:    true,
+						},
+						"offset_seconds": schema.Int64Attribute{
+							Description: "UTC offset in effect from the transition, in seconds.",
+							Computed:    true,
+						},
+						"abbreviation": schema.StringAttribute{
+							Description: "Time zone abbreviation in effect from the transition.",
+							Computed:    true,
+						},
+						"is_dst": schema.BoolAttribute{
+							Description: "Whether daylight saving time is in effect from the transition.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TimezoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data timezoneDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loc, err := loadLocation(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid time zone", err.Error())
+		return
+	}
+
+	reference := d.now().UTC()
+	if !data.Timestamp.IsNull() && !data.Timestamp.IsUnknown() {
+		reference, err = parseTimestamp(data.Timestamp.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timestamp"), "Invalid RFC3339 timestamp", err.Error())
+			return
+		}
+	}
+
+	count := defaultTransitionCount
+	if !data.TransitionCount.IsNull() {
+		if data.TransitionCount.ValueInt64() < 0 || data.TransitionCount.ValueInt64() > maxTransitionCount {
+			resp.Diagnostics.AddAttributeError(path.Root("transition_count"), "Invalid transition count",
+				fmt.Sprintf("transition_count must be between 0 and %d.", maxTransitionCount))
+			return
+		}
+		count = int(data.TransitionCount.ValueInt64())
+	}
+
+	local := reference.In(loc)
+	_, offset := local.Zone()
+
+	data.ID = types.StringValue(loc.String() + "@" + formatTimestamp(reference))
+	data.ReferenceTime = types.StringValue(formatTimestamp(reference))
+	data.LocalTime = types.StringValue(formatTimestamp(local))
+	data.Offset = types.StringValue(formatOffset(offset))
+	data.OffsetSeconds = types.Int64Value(int64(offset))
+	data.Abbreviation = types.StringValue(zoneAbbreviation(local))
+	data.IsDST = types.BoolValue(local.IsDST())
+
+	data.Transitions = []timezoneTransitionModel{}
+	if count > 0 {
+		for _, tr := range zoneTransitions(loc, reference, time.Time{}, count) {
+			data.Transitions = append(data.Transitions, timezoneTransitionModel{
+				Timestamp:     types.StringValue(formatTimestamp(tr.at.UTC())),
+				LocalTime:     types.StringValue(formatTimestamp(tr.at)),
+				Offset:        types.StringValue(formatOffset(tr.offset)),
+				OffsetSeconds: types.Int64Value(int64(tr.offset)),
+				Abbreviation:  types.StringValue(tr.abbreviation),
+				IsDST:         types.BoolValue(tr.isDST),
+			})
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,223 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTimezoneDataSource(t *testing.T) {
+	testCases := []struct {
+		name                string
+		zone                string
+		timestamp           string
+		transitionCount     *int64
+		expectedOffset      string
+		expectedAbbrev      string
+		expectedIsDST       bool
+		expectedLocalTime   string
+		expectedTransitions []string
+		expectErr           bool
+	}{
+		{
+			name:              "winter in Berlin",
+			zone:              "Europe/Berlin",
+			timestamp:         "2024-01-15T10:30:00Z",
+			expectedOffset:    "+01:00",
+			expectedAbbrev:    "CET",
+			expectedLocalTime: "2024-01-15T11:30:00+01:00",
+			expectedTransitions: []string{
+				"2024-03-31T01:00:00Z",
+				"2024-10-27T01:00:00Z",
+				"2025-03-30T01:00:00Z",
+				"2025-10-26T01:00:00Z",
+				"2026-03-29T01:00:00Z",
+			},
+		},
+		{
+			name:                "summer in New York",
+			zone:                "America/New_York",
+			timestamp:           "2024-07-04T16:00:00Z",
+			transitionCount:     int64Ptr(2),
+			expectedOffset:      "-04:00",
+			expectedAbbrev:      "EDT",
+			expectedIsDST:       true,
+			expectedLocalTime:   "2024-07-04T12:00:00-04:00",
+			expectedTransitions: []string{"2024-11-03T06:00:00Z", "2025-03-09T07:00:00Z"},
+		},
+		{
+			name:                "zone without DST",
+			zone:                "Asia/Kolkata",
+			timestamp:           "2024-01-15T10:30:00Z",
+			expectedOffset:      "+05:30",
+			expectedAbbrev:      "IST",
+			expectedLocalTime:   "2024-01-15T16:00:00+05:30",
+			expectedTransitions: []string{},
+		},
+		{
+			name:                "numeric abbreviation",
+			zone:                "America/Sao_Paulo",
+			timestamp:           "2024-01-15T10:30:00Z",
+			transitionCount:     int64Ptr(0),
+			expectedOffset:      "-03:00",
+			expectedAbbrev:      "-03:00",
+			expectedLocalTime:   "2024-01-15T07:30:00-03:00",
+			expectedTransitions: []string{},
+		},
+		{
+			name:              "after the last explicit transition",
+			zone:              "America/New_York",
+			timestamp:         "2040-06-01T00:00:00Z",
+			expectedOffset:    "-04:00",
+			expectedAbbrev:    "EDT",
+			expectedIsDST:     true,
+			expectedLocalTime: "2040-05-31T20:00:00-04:00",
+			expectedTransitions: []string{
+				"2040-11-04T06:00:00Z",
+				"2041-03-10T07:00:00Z",
+				"2041-11-03T06:00:00Z",
+				"2042-03-09T07:00:00Z",
+				"2042-11-02T06:00:00Z",
+			},
+		},
+		{
+			name:                "no transitions past the end of tzdata",
+			zone:                "America/Sao_Paulo",
+			timestamp:           "2024-01-15T10:30:00Z",
+			expectedOffset:      "-03:00",
+			expectedAbbrev:      "-03:00",
+			expectedLocalTime:   "2024-01-15T07:30:00-03:00",
+			expectedTransitions: []string{},
+		},
+		{
+			name:      "unknown zone",
+			zone:      "Mars/Olympus_Mons",
+			timestamp: "2024-01-15T10:30:00Z",
+			expectErr: true,
+		},
+		{
+			name:      "invalid timestamp",
+			zone:      "UTC",
+			timestamp: "yesterday",
+			expectErr: true,
+		},
+		{
+			name:            "negative transition count",
+			zone:            "UTC",
+			timestamp:       "2024-01-15T10:30:00Z",
+			transitionCount: int64Ptr(-1),
+			expectErr:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]tftypes.Value{
+				"name":      tftypes.NewValue(tftypes.String, tc.zone),
+				"timestamp": tftypes.NewValue(tftypes.String, tc.timestamp),
+			}
+			if tc.transitionCount != nil {
+				config["transition_count"] = tftypes.NewValue(tftypes.Number, *tc.transitionCount)
+			}
+
+			state, diags := readDataSource(t, NewTimezoneDataSource(), config)
+
+			if tc.expectErr {
+				if !diags.HasError() {
+					t.Errorf("Expected error for zone %q, but got none", tc.zone)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Errorf("Unexpected error for zone %q: %v", tc.zone, diags)
+				return
+			}
+
+			var data timezoneDataSourceModel
+			if diags := state.Get(context.Background(), &data); diags.HasError() {
+				t.Fatalf("Failed to read state: %v", diags)
+			}
+
+			if data.Offset.ValueString() != tc.expectedOffset {
+				t.Errorf("Expected offset %q, got %q", tc.expectedOffset, data.Offset.ValueString())
+			}
+
+			if data.Abbreviation.ValueString() != tc.expectedAbbrev {
+				t.Errorf("Expected abbreviation %q, got %q", tc.expectedAbbrev, data.Abbreviation.ValueString())
+			}
+
+			if data.IsDST.ValueBool() != tc.expectedIsDST {
+				t.Errorf("Expected is_dst %t, got %t", tc.expectedIsDST, data.IsDST.ValueBool())
+			}
+
+			if data.LocalTime.ValueString() != tc.expectedLocalTime {
+				t.Errorf("Expected local_time %q, got %q", tc.expectedLocalTime, data.LocalTime.ValueString())
+			}
+
+			if len(data.Transitions) != len(tc.expectedTransitions) {
+				t.Fatalf("Expected %d transitions, got %d", len(tc.expectedTransitions), len(data.Transitions))
+			}
+
+			for i, expected := range tc.expectedTransitions {
+				if got := data.Transitions[i].Timestamp.ValueString(); got != expected {
+					t.Errorf("Expected transition %d at %q, got %q", i, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestTimezoneDataSourceKeepsTimestamp(t *testing.T) {
+	state, diags := readDataSource(t, NewTimezoneDataSource(), map[string]tftypes.Value{
+		"name":      tftypes.NewValue(tftypes.String, "Europe/Berlin"),
+		"timestamp": tftypes.NewValue(tftypes.String, "2024-01-15T11:30:00.000+01:00"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if got := stateString(t, state, "timestamp"); got != "2024-01-15T11:30:00.000+01:00" {
+		t.Errorf("Expected the configured timestamp to be kept, got %q", got)
+	}
+
+	if got := stateString(t, state, "reference_time"); got != "2024-01-15T11:30:00+01:00" {
+		t.Errorf("Expected reference_time %q, got %q", "2024-01-15T11:30:00+01:00", got)
+	}
+}
+
+func TestTimezoneDataSourceDefaultsToNow(t *testing.T) {
+	t.Setenv(fixedNowEnvVar, "2024-07-04T16:00:00Z")
+
+	state, diags := readDataSource(t, NewTimezoneDataSource(), map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "America/New_York"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	var data timezoneDataSourceModel
+	if diags := state.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to read state: %v", diags)
+	}
+
+	if !data.Timestamp.IsNull() {
+		t.Errorf("Expected timestamp to stay null, got %q", data.Timestamp.ValueString())
+	}
+
+	if data.ReferenceTime.ValueString() != "2024-07-04T16:00:00Z" {
+		t.Errorf("Expected reference_time %q, got %q", "2024-07-04T16:00:00Z", data.ReferenceTime.ValueString())
+	}
+
+	if data.LocalTime.ValueString() != "2024-07-04T12:00:00-04:00" {
+		t.Errorf("Expected local_time %q, got %q", "2024-07-04T12:00:00-04:00", data.LocalTime.ValueString())
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
@@ -0,0 +1,72 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// expiresWithin reports whether t is at or before the end of window starting
+// at reference, read on the wall clock of reference's offset. Timestamps
+// that have already passed are within any window.
+func expiresWithin(t, reference time.Time, window calendarStep) bool {
+	return !t.After(window.after(reference, 1, reference.Location()))
+}
+
+// secondsBetween returns the whole seconds from from to to, rounded down, by
+// subtracting Unix times so that spans beyond the 292 years a
+// time.Duration can hold are exact.
+func secondsBetween(from, to time.Time) int64 {
+	seconds := to.Unix() - from.Unix()
+	if to.Nanosecond() < from.Nanosecond() {
+		seconds--
+	}
+
+	return seconds
+}
+
+// humanizeSeconds spells out the magnitude of a number of seconds in days,
+// hours, minutes and seconds, such as "12 days, 3 hours and 30 seconds",
+// omitting zero units. Days are 24 hours of elapsed time.
+func humanizeSeconds(seconds int64) string {
+	if seconds < 0 {
+		seconds = -seconds
+	}
+
+	var parts []string
+	for _, unit := range []struct {
+		name string
+		size int64
+	}{
+		{"day", 24 * 3600},
+		{"hour", 3600},
+		{"minute", 60},
+	} {
+		if n := seconds / unit.size; n > 0 {
+			parts = append(parts, pluralize(strconv.FormatInt(n, 10), unit.name, n == 1))
+			seconds -= n * unit.size
+		}
+	}
+
+	if seconds > 0 || len(parts) == 0 {
+		parts = append(parts, pluralize(strconv.FormatInt(seconds, 10), "second", seconds == 1))
+	}
+
+	if len(parts) == 1 {
+		return parts[0]
+	}
+
+	return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1]
+}
+
+// pluralize joins a count and a unit, adding an s unless the count is one.
+func pluralize(count, unit string, one bool) string {
+	if one {
+		return count + " " + unit
+	}
+
+	return count + " " + unit + "s"
+}
@@ -0,0 +1,144 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// rfc3339Epoch is the pseudo-epoch name for RFC3339 timestamp strings.
+const rfc3339Epoch = "rfc3339"
+
+// epoch describes a counter of fixed-size ticks from an origin instant.
+type epoch struct {
+	origin time.Time
+	// tick is the length of one counter unit.
+	tick time.Duration
+	// gps epochs count continuously without leap seconds.
+	gps bool
+}
+
+// namedEpochs is the table of epochs understood by convert_epoch.
+var namedEpochs = map[string]epoch{
+	"unix":     {origin: time.Unix(0, 0).UTC(), tick: time.Second},
+	"unix_ms":  {origin: time.Unix(0, 0).UTC(), tick: time.Millisecond},
+	"unix_us":  {origin: time.Unix(0, 0).UTC(), tick: time.Microsecond},
+	"unix_ns":  {origin: time.Unix(0, 0).UTC(), tick: time.Nanosecond},
+	"filetime": {origin: time.Date(1601, time.January, 1, 0, 0, 0, 0, time.UTC), tick: 100 * time.Nanosecond},
+	"webkit":   {origin: time.Date(1601, time.January, 1, 0, 0, 0, 0, time.UTC), tick: time.Microsecond},
+	"dotnet":   {origin: time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), tick: 100 * time.Nanosecond},
+	"ntp":      {origin: time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC), tick: time.Second},
+	"hfs":      {origin: time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC), tick: time.Second},
+	"cocoa":    {origin: time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC), tick: time.Second},
+	"gps":      {origin: time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC), tick: time.Second, gps: true},
+	"gps_ms":   {origin: time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC), tick: time.Millisecond, gps: true},
+}
+
+var integerPattern = regexp.MustCompile(`^[+-]?[0-9]+$`)
+
+// epochNames returns the sorted names accepted by lookupEpoch, including the
+// rfc3339 pseudo-epoch.
+func epochNames() []string {
+	names := []string{rfc3339Epoch}
+	for name := range namedEpochs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+func lookupEpoch(name string) (epoch, error) {
+	e, ok := namedEpochs[name]
+	if !ok && name != rfc3339Epoch {
+		return epoch{}, fmt.Errorf("unknown epoch %q, expected one of: %s", name, strings.Join(epochNames(), ", "))
+	}
+
+	return e, nil
+}
+
+// unixNanos returns the exact number of nanoseconds between the Unix epoch
+// and t.
+func unixNanos(t time.Time) *big.Int {
+	nanos := new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(int64(time.Second)))
+
+	return nanos.Add(nanos, big.NewInt(int64(t.Nanosecond())))
+}
+
+// timeFromUnixNanos is the inverse of unixNanos, rejecting instants that
+// cannot be represented as an RFC3339 timestamp.
+func timeFromUnixNanos(nanos *big.Int) (time.Time, error) {
+	sec, nsec := new(big.Int).DivMod(nanos, big.NewInt(int64(time.Second)), new(big.Int))
+	if !sec.IsInt64() {
+		return time.Time{}, errors.New("value is out of range")
+	}
+
+	t := time.Unix(sec.Int64(), nsec.Int64()).UTC()
+	if t.Year() < 1 || t.Year() > 9999 {
+		return time.Time{}, errors.New("value is out of range")
+	}
+
+	return t, nil
+}
+
+// convertEpoch converts value, a tick count in the from epoch (or an RFC3339
+// timestamp for the rfc3339 pseudo-epoch), into the to epoch. Conversions
+// between tick counts use integer arithmetic and round towards the earlier
+// instant when the target resolution is coarser than the source.
+func convertEpoch(value, from, to string) (string, error) {
+	fromEpoch, err := lookupEpoch(from)
+	if err != nil {
+		return "", err
+	}
+
+	toEpoch, err := lookupEpoch(to)
+	if err != nil {
+		return "", err
+	}
+
+	var t time.Time
+	if from == rfc3339Epoch {
+		t, err = parseTimestamp(value)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		if !integerPattern.MatchString(value) {
+			return "", fmt.Errorf("%q is not an integer tick count", value)
+		}
+
+		ticks, _ := new(big.Int).SetString(value, 10)
+		nanos := ticks.Mul(ticks, big.NewInt(int64(fromEpoch.tick)))
+		nanos.Add(nanos, unixNanos(fromEpoch.origin))
+
+		t, err = timeFromUnixNanos(nanos)
+		if err != nil {
+			return "", err
+		}
+
+		if fromEpoch.gps {
+			t = utcFromGPS(t)
+		}
+	}
+
+	if to == rfc3339Epoch {
+		return formatTimestamp(t), nil
+	}
+
+	if toEpoch.gps {
+		t = t.Add(time.Duration(gpsMinusUTC(t)) * time.Second)
+	}
+
+	nanos := unixNanos(t)
+	nanos.Sub(nanos, unixNanos(toEpoch.origin))
+	ticks := nanos.Div(nanos, big.NewInt(int64(toEpoch.tick)))
+
+	return ticks.String(), nil
+}
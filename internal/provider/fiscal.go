@@ -0,0 +1,248 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Week patterns for retail 4-4-5 style calendars, giving the number of
+// weeks in each period of a quarter.
+var fiscalWeekPatterns = map[string][3]int{
+	"4-4-5": {4, 4, 5},
+	"4-5-4": {4, 5, 4},
+	"5-4-4": {5, 4, 4},
+}
+
+// fiscalMonthlyPattern divides a fiscal year into twelve periods starting on
+// the same day of each month as the year.
+const fiscalMonthlyPattern = "monthly"
+
+// Fiscal year start rules: a fixed month and day, or a weekday relative to
+// a month.
+var (
+	fixedDatePattern      = regexp.MustCompile(`^(\d{2})-(\d{2})$`)
+	relativeWeekdayRule   = regexp.MustCompile(`^(first|last) (\w+) of (\w+)$`)
+	nearestEndOfMonthRule = regexp.MustCompile(`^(\w+) nearest (?:the )?end of (\w+)$`)
+)
+
+// fiscalCalendarSpec is the JSON form of a fiscal calendar.
+type fiscalCalendarSpec struct {
+	YearStart string `json:"year_start"`
+	Pattern   string `json:"pattern"`
+	YearLabel string `json:"year_label"`
+	Timezone  string `json:"timezone"`
+}
+
+// fiscalCalendar describes how fiscal years, quarters, periods and weeks
+// are laid out.
+type fiscalCalendar struct {
+	// rule is "fixed", "first", "last" or "nearest".
+	rule    string
+	month   time.Month
+	day     int
+	weekday time.Weekday
+
+	// weeks is the week pattern of each quarter, nil for monthly periods.
+	weeks []int
+
+	labelByStart bool
+	timezone     string
+}
+
+// fiscalPosition is where a date falls in a fiscal calendar. Bounds are
+// wall clock dates and every end is exclusive.
+type fiscalPosition struct {
+	year, quarter, period, week, weeksInYear int
+
+	yearStart, yearEnd       time.Time
+	quarterStart, quarterEnd time.Time
+	periodStart, periodEnd   time.Time
+	weekStart, weekEnd       time.Time
+}
+
+// parseFiscalCalendar parses a JSON fiscal calendar specification.
+func parseFiscalCalendar(value string) (fiscalCalendar, error) {
+	var spec fiscalCalendarSpec
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(value)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&spec); err != nil {
+		return fiscalCalendar{}, fmt.Errorf("calendar_spec must be a JSON object with year_start, pattern, year_label and timezone keys: %w", err)
+	}
+
+	cal := fiscalCalendar{timezone: spec.Timezone}
+
+	rule := strings.Join(strings.Fields(strings.ToLower(spec.YearStart)), " ")
+	if err := cal.parseYearStart(rule); err != nil {
+		return fiscalCalendar{}, err
+	}
+
+	switch pattern := strings.ToLower(spec.Pattern); {
+	case pattern == "" && cal.rule == "fixed", pattern == fiscalMonthlyPattern:
+		if cal.rule != "fixed" {
+			return fiscalCalendar{}, fmt.Errorf("pattern %q needs a fixed year_start date, weekday rules give 52 or 53 week years", fiscalMonthlyPattern)
+		}
+	case pattern == "":
+		weeks := fiscalWeekPatterns["4-4-5"]
+		cal.weeks = weeks[:]
+	default:
+		weeks, ok := fiscalWeekPatterns[pattern]
+		if !ok {
+			return fiscalCalendar{}, fmt.Errorf("unknown pattern %q, expected 4-4-5, 4-5-4, 5-4-4 or %s", spec.Pattern, fiscalMonthlyPattern)
+		}
+		if cal.rule == "fixed" {
+			return fiscalCalendar{}, fmt.Errorf("pattern %q needs a weekday year_start rule such as \"last saturday of january\" so that years are whole weeks", spec.Pattern)
+		}
+		cal.weeks = weeks[:]
+	}
+
+	switch strings.ToLower(spec.YearLabel) {
+	case "", "end":
+	case "start":
+		cal.labelByStart = true
+	default:
+		return fiscalCalendar{}, fmt.Errorf("unknown year_label %q, expected start or end", spec.YearLabel)
+	}
+
+	return cal, nil
+}
+
+// parseYearStart parses a normalized year_start rule.
+func (c *fiscalCalendar) parseYearStart(rule string) error {
+	var err error
+
+	if m := fixedDatePattern.FindStringSubmatch(rule); m != nil {
+		c.rule = "fixed"
+		if c.month, err = parseMonth(m[1]); err != nil {
+			return err
+		}
+		if c.day, err = strconv.Atoi(m[2]); err != nil || c.day < 1 || c.day > 28 {
+			return fmt.Errorf("year_start %q must have a day from 01 to 28 so that every month has it", rule)
+		}
+		return nil
+	}
+
+	var weekday, month string
+	if m := relativeWeekdayRule.FindStringSubmatch(rule); m != nil {
+		c.rule, weekday, month = m[1], m[2], m[3]
+	} else if m := nearestEndOfMonthRule.FindStringSubmatch(rule); m != nil {
+		c.rule, weekday, month = "nearest", m[1], m[2]
+	} else {
+		return fmt.Errorf("unknown year_start %q, expected MM-DD, \"first|last <weekday> of <month>\" or \"<weekday> nearest end of <month>\"", rule)
+	}
+
+	if c.weekday, err = parseWeekday(weekday); err != nil {
+		return err
+	}
+
+	c.month, err = parseMonth(month)
+
+	return err
+}
+
+// yearStart returns the date the fiscal year starting in calendar year y
+// begins.
+func (c fiscalCalendar) yearStart(y int) time.Time {
+	switch c.rule {
+	case "fixed":
+		return time.Date(y, c.month, c.day, 0, 0, 0, 0, time.UTC)
+	case "first":
+		first := time.Date(y, c.month, 1, 0, 0, 0, 0, time.UTC)
+		return first.AddDate(0, 0, (int(c.weekday)-int(first.Weekday())+7)%7)
+	case "last":
+		last := time.Date(y, c.month+1, 0, 0, 0, 0, 0, time.UTC)
+		return last.AddDate(0, 0, -((int(last.Weekday()) - int(c.weekday) + 7) % 7))
+	default:
+		last := time.Date(y, c.month+1, 0, 0, 0, 0, 0, time.UTC)
+		diff := (int(c.weekday) - int(last.Weekday()) + 7) % 7
+		if diff > 3 {
+			diff -= 7
+		}
+		return last.AddDate(0, 0, diff)
+	}
+}
+
+// position locates a wall clock date in the fiscal calendar.
+func (c fiscalCalendar) position(date time.Time) (fiscalPosition, error) {
+	var p fiscalPosition
+
+	// A year starting near the end of December can begin in the following
+	// calendar year, so the year containing a date in early January may have
+	// started two calendar years earlier.
+	found := false
+	for y := date.Year() + 1; y >= date.Year()-2 && !found; y-- {
+		p.yearStart, p.yearEnd = c.yearStart(y), c.yearStart(y+1)
+		found = !date.Before(p.yearStart) && date.Before(p.yearEnd)
+	}
+	if !found {
+		return fiscalPosition{}, fmt.Errorf("no fiscal year contains %s", date.Format(time.DateOnly))
+	}
+
+	p.year = p.yearEnd.AddDate(0, 0, -1).Year()
+	if c.labelByStart {
+		p.year = p.yearStart.Year()
+	}
+
+	days := int(date.Sub(p.yearStart) / (24 * time.Hour))
+	yearDays := int(p.yearEnd.Sub(p.yearStart) / (24 * time.Hour))
+
+	p.week = days/7 + 1
+	p.weeksInYear = (yearDays + 6) / 7
+	p.weekStart = p.yearStart.AddDate(0, 0, 7*(p.week-1))
+	p.weekEnd = minTime(p.weekStart.AddDate(0, 0, 7), p.yearEnd)
+
+	if c.weeks == nil {
+		month := 0
+		for month < 11 && !p.yearStart.AddDate(0, month+1, 0).After(date) {
+			month++
+		}
+
+		p.period = month + 1
+		p.quarter = month/3 + 1
+		p.periodStart = p.yearStart.AddDate(0, month, 0)
+		p.periodEnd = minTime(p.yearStart.AddDate(0, month+1, 0), p.yearEnd)
+		p.quarterStart = p.yearStart.AddDate(0, 3*(p.quarter-1), 0)
+		p.quarterEnd = minTime(p.yearStart.AddDate(0, 3*p.quarter, 0), p.yearEnd)
+
+		return p, nil
+	}
+
+	if yearDays%7 != 0 {
+		return fiscalPosition{}, errors.New("fiscal year is not a whole number of weeks")
+	}
+
+	// The 53rd week of a long year belongs to the final period.
+	week := min(p.week-1, 51)
+	p.quarter = week/13 + 1
+	p.quarterStart = p.yearStart.AddDate(0, 0, 7*13*(p.quarter-1))
+	p.quarterEnd = p.quarterStart.AddDate(0, 0, 7*13)
+	if p.quarter == 4 {
+		p.quarterEnd = p.yearEnd
+	}
+
+	p.period = 3 * (p.quarter - 1)
+	p.periodStart = p.quarterStart
+	for i, remaining := 0, week%13; i < len(c.weeks); i++ {
+		p.period++
+		p.periodEnd = p.periodStart.AddDate(0, 0, 7*c.weeks[i])
+		if remaining < c.weeks[i] {
+			break
+		}
+		remaining -= c.weeks[i]
+		p.periodStart = p.periodEnd
+	}
+	if p.period == 12 {
+		p.periodEnd = p.yearEnd
+	}
+
+	return p, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &AgeFunction{}
+
+type AgeFunction struct{}
+
+func NewAgeFunction() function.Function {
+	return &AgeFunction{}
+}
+
+func (f *AgeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "age"
+}
+
+func (f *AgeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Calculate the calendar age between two timestamps",
+		Description: "Returns a JSON object with the whole years, remaining whole months and remaining days from birth to reference, as a person's age is counted, and total_months, the whole months in total. " +
+			"A year or month is complete on its anniversary, including the time of day, so months of different lengths and leap years are handled without assuming fixed-length days. " +
+			"Optional arguments are an IANA time zone whose wall clock the calendar is read on, defaulting to birth's own offset when omitted or empty, " +
+			"and the leap day handling for a day missing from a shorter month such as 29 February: 'feb28' (default) counts the anniversary on the last day of that month and 'mar1' on the first day of the next. " +
+			"Timestamps may be " + timestampFormatSummary + "; dates without a time are midnight UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "birth",
+				Description: "Timestamp the age is counted from (e.g., '2020-02-29')",
+			},
+			function.StringParameter{
+				Name:        "reference",
+				Description: "Timestamp the age is measured at, not before birth",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "options",
+			Description: "Optional IANA time zone name followed by optional leap day handling (e.g., 'Europe/London', 'mar1')",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *AgeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var birthTimestamp, referenceTimestamp string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &birthTimestamp, &referenceTimestamp, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(options) > 2 {
+		resp.Error = function.NewFuncError("Invalid options: expected at most a time zone and a leap day handling")
+		return
+	}
+
+	birth, err := parseAnyTimestamp(birthTimestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid birth timestamp: " + err.Error())
+		return
+	}
+
+	reference, err := parseAnyTimestamp(referenceTimestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid reference timestamp: " + err.Error())
+		return
+	}
+
+	loc, err := optionalLocation(options[:min(len(options), 1)], birth)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+		return
+	}
+
+	policy := ""
+	if len(options) == 2 {
+		policy = options[1]
+	}
+
+	policy, err = parseLeapDayPolicy(policy)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid leap day handling: " + err.Error())
+		return
+	}
+
+	age, err := ageBetween(wallClock(birth, loc), wallClock(reference, loc), policy)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid reference timestamp: " + err.Error())
+		return
+	}
+
+	result, err := json.Marshal(map[string]string{
+		"years":        strconv.Itoa(age.months / 12),
+		"months":       strconv.Itoa(age.months % 12),
+		"days":         strconv.Itoa(age.days),
+		"total_months": strconv.Itoa(age.months),
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to encode age: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(string(result)))
+}
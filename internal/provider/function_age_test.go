@@ -0,0 +1,158 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAgeFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		birth     string
+		reference string
+		options   []string
+		expected  map[string]string
+		expectErr string
+	}{
+		{
+			name:      "day before birthday",
+			birth:     "1990-05-15",
+			reference: "2024-05-14",
+			expected:  map[string]string{"years": "33", "months": "11", "days": "29", "total_months": "407"},
+		},
+		{
+			name:      "on birthday",
+			birth:     "1990-05-15",
+			reference: "2024-05-15T00:00:00Z",
+			expected:  map[string]string{"years": "34", "months": "0", "days": "0", "total_months": "408"},
+		},
+		{
+			name:      "same instant",
+			birth:     "2024-01-15T10:00:00+01:00",
+			reference: "2024-01-15T09:00:00Z",
+			expected:  map[string]string{"years": "0", "months": "0", "days": "0", "total_months": "0"},
+		},
+		{
+			name:      "leap day birthday on 28 February",
+			birth:     "2000-02-29",
+			reference: "2023-02-28",
+			expected:  map[string]string{"years": "23", "months": "0", "days": "0"},
+		},
+		{
+			name:      "leap day birthday on 1 March",
+			birth:     "2000-02-29",
+			reference: "2023-02-28",
+			options:   []string{"", "mar1"},
+			expected:  map[string]string{"years": "22", "months": "11", "days": "30"},
+		},
+		{
+			name:      "leap day birthday reached on 1 March",
+			birth:     "2000-02-29",
+			reference: "2023-03-01",
+			options:   []string{"", "mar1"},
+			expected:  map[string]string{"years": "23", "months": "0", "days": "0"},
+		},
+		{
+			name:      "month end clamps to shorter month",
+			birth:     "2024-01-31",
+			reference: "2024-02-29",
+			expected:  map[string]string{"years": "0", "months": "1", "days": "0"},
+		},
+		{
+			name:      "month end rolls over",
+			birth:     "2024-01-31",
+			reference: "2024-02-29",
+			options:   []string{"", "mar1"},
+			expected:  map[string]string{"years": "0", "months": "0", "days": "29"},
+		},
+		{
+			name:      "anniversary needs time of day",
+			birth:     "2024-01-15T10:00:00Z",
+			reference: "2025-01-15T09:59:59Z",
+			expected:  map[string]string{"years": "0", "months": "11", "days": "30", "total_months": "11"},
+		},
+		{
+			name:      "time zone wall clock across daylight saving",
+			birth:     "2024-01-15T12:00:00Z",
+			reference: "2024-07-15T11:30:00Z",
+			options:   []string{"America/New_York"},
+			expected:  map[string]string{"years": "0", "months": "6", "days": "0"},
+		},
+		{
+			name:      "fixed offset ignores daylight saving",
+			birth:     "2024-01-15T12:00:00Z",
+			reference: "2024-07-15T11:30:00Z",
+			expected:  map[string]string{"years": "0", "months": "5", "days": "29"},
+		},
+		{
+			name:      "reference before birth",
+			birth:     "2024-01-15",
+			reference: "2024-01-14",
+			expectErr: "is before",
+		},
+		{
+			name:      "unknown leap day handling",
+			birth:     "2000-02-29",
+			reference: "2024-01-01",
+			options:   []string{"UTC", "feb29"},
+			expectErr: "Invalid leap day handling",
+		},
+		{
+			name:      "too many options",
+			birth:     "2000-02-29",
+			reference: "2024-01-01",
+			options:   []string{"UTC", "feb28", "extra"},
+			expectErr: "Invalid options",
+		},
+		{
+			name:      "invalid birth",
+			birth:     "29/02/2000",
+			reference: "2024-01-01",
+			expectErr: "Invalid birth timestamp",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewAgeFunction(), types.StringValue(tc.birth), types.StringValue(tc.reference), variadicStrings(tc.options...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for birth %q, but got none", tc.birth)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for birth %q: %v", tc.birth, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			var actual map[string]string
+			if err := json.Unmarshal([]byte(got.ValueString()), &actual); err != nil {
+				t.Errorf("Failed to parse result JSON: %v", err)
+				return
+			}
+
+			for key, expectedValue := range tc.expected {
+				if actual[key] != expectedValue {
+					t.Errorf("Expected %s=%q, got %s=%q", key, expectedValue, key, actual[key])
+				}
+			}
+		})
+	}
+}
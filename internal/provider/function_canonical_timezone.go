@@ -0,0 +1,54 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &CanonicalTimezoneFunction{}
+
+type CanonicalTimezoneFunction struct{}
+
+func NewCanonicalTimezoneFunction() function.Function {
+	return &CanonicalTimezoneFunction{}
+}
+
+func (f *CanonicalTimezoneFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "canonical_timezone"
+}
+
+func (f *CanonicalTimezoneFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Resolve a time zone link to its canonical IANA zone",
+		Description: "Returns the canonical IANA time zone for a link or alias name (e.g., 'US/Eastern' resolves to 'America/New_York'). " +
+			"Canonical names are returned unchanged. Unknown names are an error that suggests close matches.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "IANA time zone or link name (e.g., 'Asia/Calcutta')",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CanonicalTimezoneFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := loadLocation(name); err != nil {
+		resp.Error = function.NewFuncError("Invalid time zone: " + err.Error() + suggestionSuffix(name, zoneNameCandidates()))
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(canonicalZoneName(name)))
+}
@@ -0,0 +1,86 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCanonicalTimezoneFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:     "canonical zone is unchanged",
+			input:    "America/New_York",
+			expected: "America/New_York",
+		},
+		{
+			name:     "backward link",
+			input:    "US/Eastern",
+			expected: "America/New_York",
+		},
+		{
+			name:     "renamed zone",
+			input:    "Asia/Calcutta",
+			expected: "Asia/Kolkata",
+		},
+		{
+			name:     "zone merged into another country's zone",
+			input:    "Europe/Oslo",
+			expected: "Europe/Berlin",
+		},
+		{
+			name:     "UTC",
+			input:    "UTC",
+			expected: "Etc/UTC",
+		},
+		{
+			name:      "unknown zone suggests close matches",
+			input:     "America/New_Yrok",
+			expectErr: `did you mean "America/New_York"`,
+		},
+		{
+			name:      "empty",
+			input:     "",
+			expectErr: "must not be empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewCanonicalTimezoneFunction(), types.StringValue(tc.input))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
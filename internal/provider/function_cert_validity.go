@@ -0,0 +1,135 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &CertValidityFunction{}
+
+type CertValidityFunction struct{}
+
+func NewCertValidityFunction() function.Function {
+	return &CertValidityFunction{}
+}
+
+func (f *CertValidityFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cert_validity"
+}
+
+func (f *CertValidityFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Get the validity periods of the certificates in a PEM bundle",
+		Description: "Returns a JSON array with an object for each certificate in a PEM bundle, in order, with subject, issuer, serial_number (decimal), and not_before and not_after formatted as RFC3339 in UTC. " +
+			"When a reference timestamp is given as the optional argument, each object also has remaining_seconds, the whole seconds from reference to not_after, negative once the certificate has expired. " +
+			"Certificates are parsed offline without verifying signatures or chains, and PEM blocks other than CERTIFICATE, such as private keys, are skipped. " +
+			"The reference may be " + timestampFormatSummary + "; dates without a time are midnight UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "pem",
+				Description: "One or more PEM encoded certificates",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "options",
+			Description: "Optional reference timestamp to measure remaining_seconds from, usually timestamp()",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CertValidityFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bundle string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &bundle, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(options) > 1 {
+		resp.Error = function.NewFuncError("Invalid options: expected at most a reference timestamp")
+		return
+	}
+
+	var reference *time.Time
+	if len(options) == 1 {
+		t, err := parseAnyTimestamp(options[0])
+		if err != nil {
+			resp.Error = function.NewFuncError("Invalid reference timestamp: " + err.Error())
+			return
+		}
+		reference = &t
+	}
+
+	certs, err := parseCertificates(bundle)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid PEM: " + err.Error())
+		return
+	}
+
+	validity := make([]map[string]string, 0, len(certs))
+	for _, cert := range certs {
+		entry := map[string]string{
+			"subject":       cert.Subject.String(),
+			"issuer":        cert.Issuer.String(),
+			"serial_number": cert.SerialNumber.String(),
+			"not_before":    formatTimestamp(cert.NotBefore.UTC()),
+			"not_after":     formatTimestamp(cert.NotAfter.UTC()),
+		}
+		if reference != nil {
+			entry["remaining_seconds"] = strconv.FormatInt(secondsBetween(*reference, cert.NotAfter), 10)
+		}
+		validity = append(validity, entry)
+	}
+
+	result, err := json.Marshal(validity)
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to encode certificate validity: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(string(result)))
+}
+
+// parseCertificates parses every CERTIFICATE block in a PEM bundle, skipping
+// other block types and any text between blocks.
+func parseCertificates(bundle string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	rest := []byte(bundle)
+	for {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("certificate %d: %w", len(certs), err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no CERTIFICATE blocks found")
+	}
+
+	return certs, nil
+}
@@ -0,0 +1,181 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testCertificatePEM creates a self-signed certificate valid between the
+// given RFC3339 timestamps and returns it PEM encoded.
+func testCertificatePEM(t *testing.T, commonName string, serial int64, notBefore, notAfter string) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName},
+	}
+	if template.NotBefore, err = time.Parse(time.RFC3339, notBefore); err != nil {
+		t.Fatalf("Invalid not_before: %v", err)
+	}
+	if template.NotAfter, err = time.Parse(time.RFC3339, notAfter); err != nil {
+		t.Fatalf("Invalid not_after: %v", err)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestCertValidityFunction(t *testing.T) {
+	leaf := testCertificatePEM(t, "www.example.com", 4096, "2024-01-01T00:00:00Z", "2024-04-01T00:00:00Z")
+	root := testCertificatePEM(t, "Example Root CA", 1, "2020-06-15T12:00:00+02:00", "2040-06-15T12:00:00+02:00")
+	// RFC 5280 section 4.1.2.5 uses this notAfter for certificates with no
+	// well-defined expiration date.
+	forever := testCertificatePEM(t, "Device 42", 42, "2024-01-01T00:00:00Z", "9999-12-31T23:59:59Z")
+	key := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("not a key")}))
+
+	leafValidity := map[string]string{
+		"subject":       "CN=www.example.com",
+		"issuer":        "CN=www.example.com",
+		"serial_number": "4096",
+		"not_before":    "2024-01-01T00:00:00Z",
+		"not_after":     "2024-04-01T00:00:00Z",
+	}
+	rootValidity := map[string]string{
+		"subject":       "CN=Example Root CA",
+		"issuer":        "CN=Example Root CA",
+		"serial_number": "1",
+		"not_before":    "2020-06-15T10:00:00Z",
+		"not_after":     "2040-06-15T10:00:00Z",
+	}
+	foreverValidity := map[string]string{
+		"subject":       "CN=Device 42",
+		"issuer":        "CN=Device 42",
+		"serial_number": "42",
+		"not_before":    "2024-01-01T00:00:00Z",
+		"not_after":     "9999-12-31T23:59:59Z",
+	}
+	with := func(m map[string]string, remaining string) map[string]string {
+		out := map[string]string{"remaining_seconds": remaining}
+		for k, v := range m {
+			out[k] = v
+		}
+		return out
+	}
+
+	testCases := []struct {
+		name      string
+		pem       string
+		options   []string
+		expected  []map[string]string
+		expectErr string
+	}{
+		{
+			name:     "single certificate",
+			pem:      leaf,
+			expected: []map[string]string{leafValidity},
+		},
+		{
+			name:     "bundle with remaining seconds",
+			pem:      leaf + root,
+			options:  []string{"2024-03-31T23:00:00Z"},
+			expected: []map[string]string{with(leafValidity, "3600"), with(rootValidity, "511441200")},
+		},
+		{
+			name:     "expired certificate",
+			pem:      leaf,
+			options:  []string{"2024-04-02"},
+			expected: []map[string]string{with(leafValidity, "-86400")},
+		},
+		{
+			name:     "no well-defined expiration date",
+			pem:      forever,
+			options:  []string{"2024-01-01"},
+			expected: []map[string]string{with(foreverValidity, "251698233599")},
+		},
+		{
+			name:     "sub-second reference rounds down",
+			pem:      leaf,
+			options:  []string{"2024-03-31T23:00:00.5Z"},
+			expected: []map[string]string{with(leafValidity, "3599")},
+		},
+		{
+			name:     "other blocks and text are skipped",
+			pem:      "subject=CN=www.example.com\n" + key + leaf,
+			expected: []map[string]string{leafValidity},
+		},
+		{
+			name:      "no certificates",
+			pem:       key,
+			expectErr: "no CERTIFICATE blocks",
+		},
+		{
+			name:      "corrupt certificate",
+			pem:       leaf + string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")})),
+			expectErr: "certificate 1",
+		},
+		{
+			name:      "invalid reference",
+			pem:       leaf,
+			options:   []string{"now"},
+			expectErr: "Invalid reference timestamp",
+		},
+		{
+			name:      "too many options",
+			pem:       leaf,
+			options:   []string{"2024-01-01", "UTC"},
+			expectErr: "Invalid options",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewCertValidityFunction(), types.StringValue(tc.pem), variadicStrings(tc.options...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var got []map[string]string
+			if err := json.Unmarshal([]byte(result.(types.String).ValueString()), &got); err != nil {
+				t.Fatalf("Result is not a JSON array of objects: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ConvertEpochFunction{}
+
+type ConvertEpochFunction struct{}
+
+func NewConvertEpochFunction() function.Function {
+	return &ConvertEpochFunction{}
+}
+
+func (f *ConvertEpochFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "convert_epoch"
+}
+
+func (f *ConvertEpochFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a timestamp between epochs",
+		Description: "Converts an integer tick count from one named epoch to another using exact integer arithmetic, returning the result as a string. " +
+			"Supported epochs are: unix, unix_ms, unix_us, unix_ns (1970-01-01), filetime (Windows FILETIME, 100ns ticks since 1601-01-01), " +
+			"webkit (microseconds since 1601-01-01), dotnet (.NET ticks, 100ns since 0001-01-01), ntp (seconds since 1900-01-01), " +
+			"hfs (seconds since 1904-01-01), cocoa (Apple Cocoa/Core Data seconds since 2001-01-01), and gps, gps_ms (GPS time since 1980-01-06, which does not count leap seconds). " +
+			"The pseudo-epoch rfc3339 accepts or returns an RFC3339 timestamp. " +
+			"When the target resolution is coarser than the source the result is rounded down.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "Integer tick count, or an RFC3339 timestamp when from_epoch is 'rfc3339'",
+			},
+			function.StringParameter{
+				Name:        "from_epoch",
+				Description: "Name of the epoch value is expressed in (e.g., 'filetime')",
+			},
+			function.StringParameter{
+				Name:        "to_epoch",
+				Description: "Name of the epoch to convert to (e.g., 'unix' or 'rfc3339')",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ConvertEpochFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, fromEpoch, toEpoch string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value, &fromEpoch, &toEpoch))
+	if resp.Error != nil {
+		return
+	}
+
+	converted, err := convertEpoch(strings.TrimSpace(value), fromEpoch, toEpoch)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid epoch conversion: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(converted))
+}
@@ -0,0 +1,163 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConvertEpochFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		value     string
+		from      string
+		to        string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "filetime to unix",
+			value:    "133500744000000000",
+			from:     "filetime",
+			to:       "unix",
+			expected: "1705600800",
+		},
+		{
+			name:     "unix to filetime",
+			value:    "1705600800",
+			from:     "unix",
+			to:       "filetime",
+			expected: "133500744000000000",
+		},
+		{
+			name:     "filetime to rfc3339",
+			value:    "133500744000000000",
+			from:     "filetime",
+			to:       "rfc3339",
+			expected: "2024-01-18T18:00:00Z",
+		},
+		{
+			name:     "dotnet ticks to rfc3339",
+			value:    "638411976000000000",
+			from:     "dotnet",
+			to:       "rfc3339",
+			expected: "2024-01-18T18:00:00Z",
+		},
+		{
+			name:     "dotnet ticks beyond float precision",
+			value:    "638411976000000001",
+			from:     "dotnet",
+			to:       "unix_ns",
+			expected: "1705600800000000100",
+		},
+		{
+			name:     "rfc3339 to ntp",
+			value:    "2024-01-18T18:00:00Z",
+			from:     "rfc3339",
+			to:       "ntp",
+			expected: "3914589600",
+		},
+		{
+			name:     "cocoa to unix",
+			value:    "0",
+			from:     "cocoa",
+			to:       "unix",
+			expected: "978307200",
+		},
+		{
+			name:     "gps epoch",
+			value:    "1980-01-06T00:00:00Z",
+			from:     "rfc3339",
+			to:       "gps",
+			expected: "0",
+		},
+		{
+			name:     "gps includes leap seconds",
+			value:    "2024-01-18T18:00:00Z",
+			from:     "rfc3339",
+			to:       "gps",
+			expected: "1389636018",
+		},
+		{
+			name:     "gps to rfc3339",
+			value:    "1389636018",
+			from:     "gps",
+			to:       "rfc3339",
+			expected: "2024-01-18T18:00:00Z",
+		},
+		{
+			name:     "gps_ms to unix_ms",
+			value:    "1389636018000",
+			from:     "gps_ms",
+			to:       "unix_ms",
+			expected: "1705600800000",
+		},
+		{
+			name:     "coarser target rounds down",
+			value:    "1705600800999",
+			from:     "unix_ms",
+			to:       "unix",
+			expected: "1705600800",
+		},
+		{
+			name:     "negative values round down",
+			value:    "-1",
+			from:     "unix_ms",
+			to:       "unix",
+			expected: "-1",
+		},
+		{
+			name:      "unknown epoch",
+			value:     "0",
+			from:      "excel",
+			to:        "unix",
+			expectErr: true,
+		},
+		{
+			name:      "fractional value",
+			value:     "1.5",
+			from:      "unix",
+			to:        "filetime",
+			expectErr: true,
+		},
+		{
+			name:      "out of range",
+			value:     "999999999999999999",
+			from:      "unix",
+			to:        "rfc3339",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewConvertEpochFunction(),
+				types.StringValue(tc.value), types.StringValue(tc.from), types.StringValue(tc.to))
+
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.value)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.value, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -51,20 +50,19 @@ func (f *DaysDifferenceFunction) Run(ctx context.Context, req function.RunReques
 		return
 	}
 
-	startTime, err := time.Parse(time.RFC3339, startTimestamp)
+	startTime, err := parseTimestamp(startTimestamp)
 	if err != nil {
 		resp.Error = function.NewFuncError("Invalid start timestamp: " + err.Error())
 		return
 	}
 
-	endTime, err := time.Parse(time.RFC3339, endTimestamp)
+	endTime, err := parseTimestamp(endTimestamp)
 	if err != nil {
 		resp.Error = function.NewFuncError("Invalid end timestamp: " + err.Error())
 		return
 	}
 
-	duration := endTime.Sub(startTime)
-	days := int(duration.Hours() / 24)
+	days := calendarDays(startTime, endTime)
 
 	resp.Result = function.NewResultData(types.StringValue(strconv.Itoa(days)))
 }
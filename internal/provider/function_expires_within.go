@@ -0,0 +1,76 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ExpiresWithinFunction{}
+
+type ExpiresWithinFunction struct{}
+
+func NewExpiresWithinFunction() function.Function {
+	return &ExpiresWithinFunction{}
+}
+
+func (f *ExpiresWithinFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "expires_within"
+}
+
+func (f *ExpiresWithinFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a timestamp falls within a duration of a reference time",
+		Description: "Returns true if the timestamp is at or before reference plus duration, including timestamps that have already passed, for example to check that a certificate or reserved instance does not lapse within 30 days. " +
+			"The duration may be a Go duration ('720h'), an ISO 8601 duration ('P1M') or a calendar unit with an optional count ('30 days'); calendar parts are added on the wall clock of reference's offset. " +
+			"Timestamps may be " + timestampFormatSummary + "; dates without a time are midnight UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "Expiry or deadline to check",
+			},
+			function.StringParameter{
+				Name:        "duration",
+				Description: "Window after reference (e.g., '30 days', 'P1M', '72h')",
+			},
+			function.StringParameter{
+				Name:        "reference",
+				Description: "Time the window starts from, usually timestamp()",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *ExpiresWithinFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp, duration, referenceTimestamp string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &duration, &referenceTimestamp))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseAnyTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamp: " + err.Error())
+		return
+	}
+
+	window, err := parseCalendarStep(duration)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid duration: " + err.Error())
+		return
+	}
+
+	reference, err := parseAnyTimestamp(referenceTimestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid reference timestamp: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.BoolValue(expiresWithin(t, reference, window)))
+}
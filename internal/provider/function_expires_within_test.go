@@ -0,0 +1,124 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpiresWithinFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		timestamp string
+		duration  string
+		reference string
+		expected  bool
+		expectErr string
+	}{
+		{
+			name:      "within 30 days",
+			timestamp: "2024-02-10T00:00:00Z",
+			duration:  "30 days",
+			reference: "2024-01-15T10:30:00Z",
+			expected:  true,
+		},
+		{
+			name:      "beyond 30 days",
+			timestamp: "2024-03-01T00:00:00Z",
+			duration:  "30 days",
+			reference: "2024-01-15T10:30:00Z",
+			expected:  false,
+		},
+		{
+			name:      "exactly at the end of the window",
+			timestamp: "2024-02-14T10:30:00Z",
+			duration:  "P30D",
+			reference: "2024-01-15T10:30:00Z",
+			expected:  true,
+		},
+		{
+			name:      "one second after the window",
+			timestamp: "2024-02-14T10:30:01Z",
+			duration:  "720h",
+			reference: "2024-01-15T10:30:00Z",
+			expected:  false,
+		},
+		{
+			name:      "already expired",
+			timestamp: "2023-12-31",
+			duration:  "1 day",
+			reference: "2024-01-15T10:30:00Z",
+			expected:  true,
+		},
+		{
+			name:      "calendar month from the end of January",
+			timestamp: "2024-02-29T12:00:00+01:00",
+			duration:  "1 month",
+			reference: "2024-01-31T12:00:00+01:00",
+			expected:  true,
+		},
+		{
+			name:      "offsets compared as instants",
+			timestamp: "2024-01-16T03:00:00+02:00",
+			duration:  "12h",
+			reference: "2024-01-15T12:00:00Z",
+			expected:  false,
+		},
+		{
+			name:      "unix timestamp",
+			timestamp: "1705316400",
+			duration:  "PT1H",
+			reference: "2024-01-15T10:00:00Z",
+			expected:  true,
+		},
+		{
+			name:      "invalid timestamp",
+			timestamp: "next week",
+			duration:  "30 days",
+			reference: "2024-01-15T10:30:00Z",
+			expectErr: "Invalid timestamp",
+		},
+		{
+			name:      "invalid duration",
+			timestamp: "2024-02-10T00:00:00Z",
+			duration:  "a while",
+			reference: "2024-01-15T10:30:00Z",
+			expectErr: "Invalid duration",
+		},
+		{
+			name:      "invalid reference",
+			timestamp: "2024-02-10T00:00:00Z",
+			duration:  "30 days",
+			reference: "",
+			expectErr: "Invalid reference timestamp",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewExpiresWithinFunction(),
+				types.StringValue(tc.timestamp), types.StringValue(tc.duration), types.StringValue(tc.reference))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := result.(types.Bool).ValueBool(); got != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
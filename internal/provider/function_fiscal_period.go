@@ -0,0 +1,113 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &FiscalPeriodFunction{}
+
+type FiscalPeriodFunction struct{}
+
+func NewFiscalPeriodFunction() function.Function {
+	return &FiscalPeriodFunction{}
+}
+
+func (f *FiscalPeriodFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "fiscal_period"
+}
+
+func (f *FiscalPeriodFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Locate a timestamp in a fiscal calendar",
+		Description: "Returns a JSON object with the fiscal_year, quarter, period, week and weeks_in_year containing the timestamp, " +
+			"and the start and exclusive end of the year, quarter, period and week (year_start, year_end, quarter_start, and so on). " +
+			"The calendar_spec is a JSON object: year_start is a fixed MM-DD date, 'first <weekday> of <month>', 'last <weekday> of <month>' or '<weekday> nearest end of <month>'; " +
+			"pattern is 4-4-5, 4-5-4 or 5-4-4 for 52/53 week retail calendars (the default for weekday rules, with the 53rd week added to the last period) or monthly (the default for fixed dates); " +
+			"year_label is end (default) or start, naming the fiscal year by the calendar year it ends or starts in; " +
+			"and timezone is an optional IANA time zone whose dates are used, defaulting to the timestamp's own offset.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "RFC3339 formatted timestamp",
+			},
+			function.StringParameter{
+				Name:        "calendar_spec",
+				Description: "JSON fiscal calendar specification (e.g., '{\"year_start\":\"last saturday of january\",\"pattern\":\"4-4-5\"}')",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FiscalPeriodFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp, spec string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &spec))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamp: " + err.Error())
+		return
+	}
+
+	cal, err := parseFiscalCalendar(spec)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid calendar_spec: " + err.Error())
+		return
+	}
+
+	loc, err := optionalLocation([]string{cal.timezone}, t)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+		return
+	}
+
+	date, _ := startOfUnit(wallClock(t, loc), unitDay, time.Monday)
+
+	pos, err := cal.position(date)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid calendar_spec: " + err.Error())
+		return
+	}
+
+	start := func(wall time.Time) string {
+		return formatTimestamp(instantAtWallClock(wall, loc, t, true).In(loc))
+	}
+	end := func(wall time.Time) string {
+		return formatTimestamp(instantAtWallClock(wall, loc, t, false).In(loc))
+	}
+
+	result, err := json.Marshal(map[string]string{
+		"fiscal_year":   strconv.Itoa(pos.year),
+		"quarter":       strconv.Itoa(pos.quarter),
+		"period":        strconv.Itoa(pos.period),
+		"week":          strconv.Itoa(pos.week),
+		"weeks_in_year": strconv.Itoa(pos.weeksInYear),
+		"year_start":    start(pos.yearStart),
+		"year_end":      end(pos.yearEnd),
+		"quarter_start": start(pos.quarterStart),
+		"quarter_end":   end(pos.quarterEnd),
+		"period_start":  start(pos.periodStart),
+		"period_end":    end(pos.periodEnd),
+		"week_start":    start(pos.weekStart),
+		"week_end":      end(pos.weekEnd),
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to encode fiscal period: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(string(result)))
+}
@@ -0,0 +1,241 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFiscalPeriodFunction(t *testing.T) {
+	const retail445 = `{"year_start":"last saturday of january","pattern":"4-4-5"}`
+
+	testCases := []struct {
+		name      string
+		timestamp string
+		spec      string
+		expected  map[string]string
+		expectErr string
+	}{
+		{
+			name:      "retail 4-4-5 calendar",
+			timestamp: "2024-05-15T12:00:00Z",
+			spec:      retail445,
+			expected: map[string]string{
+				"fiscal_year":   "2025",
+				"quarter":       "2",
+				"period":        "4",
+				"week":          "16",
+				"weeks_in_year": "52",
+				"year_start":    "2024-01-27T00:00:00Z",
+				"year_end":      "2025-01-25T00:00:00Z",
+				"quarter_start": "2024-04-27T00:00:00Z",
+				"quarter_end":   "2024-07-27T00:00:00Z",
+				"period_start":  "2024-04-27T00:00:00Z",
+				"period_end":    "2024-05-25T00:00:00Z",
+				"week_start":    "2024-05-11T00:00:00Z",
+				"week_end":      "2024-05-18T00:00:00Z",
+			},
+		},
+		{
+			name:      "53rd week belongs to the final period",
+			timestamp: "2021-01-25T12:00:00Z",
+			spec:      retail445,
+			expected: map[string]string{
+				"fiscal_year":   "2021",
+				"quarter":       "4",
+				"period":        "12",
+				"week":          "53",
+				"weeks_in_year": "53",
+				"year_start":    "2020-01-25T00:00:00Z",
+				"year_end":      "2021-01-30T00:00:00Z",
+				"period_start":  "2020-12-19T00:00:00Z",
+				"period_end":    "2021-01-30T00:00:00Z",
+			},
+		},
+		{
+			name:      "5-4-4 calendar labelled by start year",
+			timestamp: "2024-03-01T12:00:00Z",
+			spec:      `{"year_start":"sunday nearest end of january","pattern":"5-4-4","year_label":"start"}`,
+			expected: map[string]string{
+				"fiscal_year":  "2024",
+				"quarter":      "1",
+				"period":       "1",
+				"year_start":   "2024-01-28T00:00:00Z",
+				"period_start": "2024-01-28T00:00:00Z",
+				"period_end":   "2024-03-03T00:00:00Z",
+			},
+		},
+		{
+			name:      "year starting in January of the next calendar year",
+			timestamp: "2022-01-01T12:00:00Z",
+			spec:      `{"year_start":"monday nearest end of december","pattern":"4-4-5"}`,
+			expected: map[string]string{
+				"fiscal_year":   "2022",
+				"period":        "12",
+				"week":          "53",
+				"weeks_in_year": "53",
+				"year_start":    "2020-12-28T00:00:00Z",
+				"year_end":      "2022-01-03T00:00:00Z",
+			},
+		},
+		{
+			name:      "last day before a year starting in January",
+			timestamp: "2022-01-02T23:59:59Z",
+			spec:      `{"year_start":"monday nearest end of december","pattern":"4-4-5"}`,
+			expected: map[string]string{
+				"fiscal_year":   "2022",
+				"period":        "12",
+				"week":          "53",
+				"weeks_in_year": "53",
+				"year_start":    "2020-12-28T00:00:00Z",
+				"year_end":      "2022-01-03T00:00:00Z",
+				"week_start":    "2021-12-27T00:00:00Z",
+				"week_end":      "2022-01-03T00:00:00Z",
+			},
+		},
+		{
+			name:      "new year's day in a year that started the previous January",
+			timestamp: "2023-01-01T12:00:00Z",
+			spec:      `{"year_start":"monday nearest end of december","pattern":"4-4-5"}`,
+			expected: map[string]string{
+				"fiscal_year":   "2023",
+				"period":        "12",
+				"week":          "52",
+				"weeks_in_year": "52",
+				"year_start":    "2022-01-03T00:00:00Z",
+				"year_end":      "2023-01-02T00:00:00Z",
+			},
+		},
+		{
+			name:      "4-5-4 calendar starting on the first monday",
+			timestamp: "2024-02-20T12:00:00Z",
+			spec:      `{"year_start":"first monday of january","pattern":"4-5-4","year_label":"start"}`,
+			expected: map[string]string{
+				"fiscal_year":  "2024",
+				"period":       "2",
+				"period_start": "2024-01-29T00:00:00Z",
+				"period_end":   "2024-03-04T00:00:00Z",
+			},
+		},
+		{
+			name:      "monthly periods from a fixed date",
+			timestamp: "2024-05-15T12:00:00Z",
+			spec:      `{"year_start":"04-06"}`,
+			expected: map[string]string{
+				"fiscal_year":   "2025",
+				"quarter":       "1",
+				"period":        "2",
+				"week":          "6",
+				"period_start":  "2024-05-06T00:00:00Z",
+				"period_end":    "2024-06-06T00:00:00Z",
+				"quarter_start": "2024-04-06T00:00:00Z",
+				"quarter_end":   "2024-07-06T00:00:00Z",
+			},
+		},
+		{
+			name:      "fixed date in a time zone",
+			timestamp: "2024-04-01T02:00:00Z",
+			spec:      `{"year_start":"10-01","timezone":"America/New_York"}`,
+			expected: map[string]string{
+				"fiscal_year":   "2024",
+				"quarter":       "2",
+				"period":        "6",
+				"year_start":    "2023-10-01T00:00:00-04:00",
+				"year_end":      "2024-10-01T00:00:00-04:00",
+				"quarter_start": "2024-01-01T00:00:00-05:00",
+				"period_end":    "2024-04-01T00:00:00-04:00",
+			},
+		},
+		{
+			name:      "invalid JSON",
+			timestamp: "2024-05-15T12:00:00Z",
+			spec:      "last saturday of january",
+			expectErr: "must be a JSON object",
+		},
+		{
+			name:      "unknown key",
+			timestamp: "2024-05-15T12:00:00Z",
+			spec:      `{"year_start":"01-01","weeks":"4-4-5"}`,
+			expectErr: "unknown field",
+		},
+		{
+			name:      "unknown year start",
+			timestamp: "2024-05-15T12:00:00Z",
+			spec:      `{"year_start":"second tuesday of march"}`,
+			expectErr: "unknown year_start",
+		},
+		{
+			name:      "fixed date past the 28th",
+			timestamp: "2024-05-15T12:00:00Z",
+			spec:      `{"year_start":"01-31"}`,
+			expectErr: "day from 01 to 28",
+		},
+		{
+			name:      "week pattern with fixed date",
+			timestamp: "2024-05-15T12:00:00Z",
+			spec:      `{"year_start":"02-01","pattern":"4-4-5"}`,
+			expectErr: "needs a weekday year_start rule",
+		},
+		{
+			name:      "monthly pattern with weekday rule",
+			timestamp: "2024-05-15T12:00:00Z",
+			spec:      `{"year_start":"last saturday of january","pattern":"monthly"}`,
+			expectErr: "needs a fixed year_start date",
+		},
+		{
+			name:      "unknown pattern",
+			timestamp: "2024-05-15T12:00:00Z",
+			spec:      `{"year_start":"last saturday of january","pattern":"4-4-4"}`,
+			expectErr: "unknown pattern",
+		},
+		{
+			name:      "unknown year label",
+			timestamp: "2024-05-15T12:00:00Z",
+			spec:      `{"year_start":"01-01","year_label":"middle"}`,
+			expectErr: "unknown year_label",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewFiscalPeriodFunction(), types.StringValue(tc.timestamp), types.StringValue(tc.spec))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for spec %q, but got none", tc.spec)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for spec %q: %v", tc.spec, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			var actual map[string]string
+			if err := json.Unmarshal([]byte(got.ValueString()), &actual); err != nil {
+				t.Errorf("Failed to parse result JSON: %v", err)
+				return
+			}
+
+			for key, expectedValue := range tc.expected {
+				if actual[key] != expectedValue {
+					t.Errorf("Expected %s=%q, got %s=%q", key, expectedValue, key, actual[key])
+				}
+			}
+		})
+	}
+}
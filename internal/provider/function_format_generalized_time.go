@@ -0,0 +1,85 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &FormatGeneralizedTimeFunction{}
+
+type FormatGeneralizedTimeFunction struct{}
+
+func NewFormatGeneralizedTimeFunction() function.Function {
+	return &FormatGeneralizedTimeFunction{}
+}
+
+func (f *FormatGeneralizedTimeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_generalized_time"
+}
+
+func (f *FormatGeneralizedTimeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Format a timestamp as an ASN.1 GeneralizedTime",
+		Description: "Formats a timestamp as an ASN.1 GeneralizedTime with seconds (e.g., '20240115103000Z'), adding fractional seconds without trailing zeros only when present. " +
+			"By default the time is in UTC with a Z suffix, the DER form used by X.509 and LDAP. " +
+			"With the optional IANA time zone argument it is on that zone's wall clock with a +HHMM offset, or Z where the offset is zero. " +
+			"The timestamp may be " + timestampFormatSummary + "; dates without a time are midnight UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "Timestamp to format",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "options",
+			Description: "Optional IANA time zone to format the time in instead of UTC",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FormatGeneralizedTimeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	t, loc, funcErr := asn1FormatArguments(timestamp, options)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatGeneralizedTime(t.In(loc))))
+}
+
+// asn1FormatArguments parses the timestamp and optional time zone taken by
+// the ASN.1 formatting functions.
+func asn1FormatArguments(timestamp string, options []string) (time.Time, *time.Location, *function.FuncError) {
+	if len(options) > 1 {
+		return time.Time{}, nil, function.NewFuncError("Invalid options: expected at most a time zone")
+	}
+
+	t, err := parseAnyTimestamp(timestamp)
+	if err != nil {
+		return time.Time{}, nil, function.NewFuncError("Invalid timestamp: " + err.Error())
+	}
+
+	loc := time.UTC
+	if len(options) == 1 && options[0] != "" {
+		if loc, err = loadLocation(options[0]); err != nil {
+			return time.Time{}, nil, function.NewFuncError("Invalid time zone: " + err.Error())
+		}
+	}
+
+	return t, loc, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFormatGeneralizedTimeFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		timestamp string
+		options   []string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:      "UTC",
+			timestamp: "2024-01-15T10:30:00Z",
+			expected:  "20240115103000Z",
+		},
+		{
+			name:      "offset converted to UTC",
+			timestamp: "2024-01-15T10:30:00+05:30",
+			expected:  "20240115050000Z",
+		},
+		{
+			name:      "fractional seconds without trailing zeros",
+			timestamp: "2024-01-15T10:30:00.120Z",
+			expected:  "20240115103000.12Z",
+		},
+		{
+			name:      "date only",
+			timestamp: "2024-01-15",
+			expected:  "20240115000000Z",
+		},
+		{
+			name:      "unix timestamp",
+			timestamp: "1705314600",
+			expected:  "20240115103000Z",
+		},
+		{
+			name:      "in a time zone",
+			timestamp: "2024-01-15T10:30:00Z",
+			options:   []string{"America/New_York"},
+			expected:  "20240115053000-0500",
+		},
+		{
+			name:      "zone at a zero offset",
+			timestamp: "2024-01-15T10:30:00Z",
+			options:   []string{"Europe/London"},
+			expected:  "20240115103000Z",
+		},
+		{
+			name:      "invalid timestamp",
+			timestamp: "yesterday",
+			expectErr: "Invalid timestamp",
+		},
+		{
+			name:      "invalid time zone",
+			timestamp: "2024-01-15T10:30:00Z",
+			options:   []string{"Mars/Olympus"},
+			expectErr: "Invalid time zone",
+		},
+		{
+			name:      "too many options",
+			timestamp: "2024-01-15T10:30:00Z",
+			options:   []string{"UTC", "UTC"},
+			expectErr: "Invalid options",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewFormatGeneralizedTimeFunction(), types.StringValue(tc.timestamp), variadicStrings(tc.options...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := result.(types.String).ValueString(); got != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &FormatUTCTimeFunction{}
+
+type FormatUTCTimeFunction struct{}
+
+func NewFormatUTCTimeFunction() function.Function {
+	return &FormatUTCTimeFunction{}
+}
+
+func (f *FormatUTCTimeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_utctime"
+}
+
+func (f *FormatUTCTimeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Format a timestamp as an ASN.1 UTCTime",
+		Description: "Formats a timestamp as an ASN.1 UTCTime with a two-digit year and seconds (e.g., '240115103000Z'), dropping any fractional seconds. " +
+			"Only years 1950 to 2049 can be represented; RFC 5280 uses GeneralizedTime for other years. " +
+			"By default the time is in UTC with a Z suffix, as X.509 requires. " +
+			"With the optional IANA time zone argument it is on that zone's wall clock with a +HHMM offset, or Z where the offset is zero. " +
+			"The timestamp may be " + timestampFormatSummary + "; dates without a time are midnight UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "Timestamp to format",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "options",
+			Description: "Optional IANA time zone to format the time in instead of UTC",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FormatUTCTimeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	t, loc, funcErr := asn1FormatArguments(timestamp, options)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	value, err := formatUTCTime(t.In(loc))
+	if err != nil {
+		resp.Error = function.NewFuncError("Cannot format as UTCTime: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(value))
+}
@@ -0,0 +1,103 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFormatUTCTimeFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		timestamp string
+		options   []string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:      "UTC",
+			timestamp: "2024-01-15T10:30:00Z",
+			expected:  "240115103000Z",
+		},
+		{
+			name:      "fraction dropped",
+			timestamp: "2024-01-15T10:30:00.999Z",
+			expected:  "240115103000Z",
+		},
+		{
+			name:      "offset converted to UTC",
+			timestamp: "2024-01-15T10:30:00-05:00",
+			expected:  "240115153000Z",
+		},
+		{
+			name:      "in a time zone",
+			timestamp: "2024-07-15T10:30:00Z",
+			options:   []string{"Europe/Berlin"},
+			expected:  "240715123000+0200",
+		},
+		{
+			name:      "first year of the window",
+			timestamp: "1950-01-01T00:00:00Z",
+			expected:  "500101000000Z",
+		},
+		{
+			name:      "last second of the window",
+			timestamp: "2049-12-31T23:59:59Z",
+			expected:  "491231235959Z",
+		},
+		{
+			name:      "after the window",
+			timestamp: "2050-01-01T00:00:00Z",
+			expectErr: "outside the UTCTime range",
+		},
+		{
+			name:      "before the window",
+			timestamp: "1949-12-31T23:59:59Z",
+			expectErr: "outside the UTCTime range",
+		},
+		{
+			name:      "window checked in the time zone",
+			timestamp: "2049-12-31T23:30:00Z",
+			options:   []string{"Europe/Berlin"},
+			expectErr: "year 2050",
+		},
+		{
+			name:      "invalid timestamp",
+			timestamp: "yesterday",
+			expectErr: "Invalid timestamp",
+		},
+		{
+			name:      "invalid time zone",
+			timestamp: "2024-01-15T10:30:00Z",
+			options:   []string{"Mars/Olympus"},
+			expectErr: "Invalid time zone",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewFormatUTCTimeFunction(), types.StringValue(tc.timestamp), variadicStrings(tc.options...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := result.(types.String).ValueString(); got != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
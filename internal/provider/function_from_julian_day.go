@@ -0,0 +1,59 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &FromJulianDayFunction{}
+
+type FromJulianDayFunction struct{}
+
+func NewFromJulianDayFunction() function.Function {
+	return &FromJulianDayFunction{}
+}
+
+func (f *FromJulianDayFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "from_julian_day"
+}
+
+func (f *FromJulianDayFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert Julian Day to timestamp",
+		Description: "Converts a decimal Julian Day into an RFC3339 timestamp in UTC. Fractional days are honoured and the result is rounded to the nearest millisecond.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "julian_day",
+				Description: "Julian Day as a decimal string (e.g., '2460325.1875')",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FromJulianDayFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	days, err := parseFractionalDays(value)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid Julian Day: " + err.Error())
+		return
+	}
+
+	t, err := timeFromDays(days, julianDayUnixEpoch)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid Julian Day: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(t)))
+}
@@ -0,0 +1,83 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFromJulianDayFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "whole day is noon UTC",
+			input:    "2451545",
+			expected: "2000-01-01T12:00:00Z",
+		},
+		{
+			name:     "fractional day",
+			input:    "2460325.1875",
+			expected: "2024-01-15T16:30:00Z",
+		},
+		{
+			name:     "round trip of seconds",
+			input:    "2460324.938020833",
+			expected: "2024-01-15T10:30:45Z",
+		},
+		{
+			name:     "unix epoch",
+			input:    "2440587.5",
+			expected: "1970-01-01T00:00:00Z",
+		},
+		{
+			name:      "not a number",
+			input:     "abc",
+			expectErr: true,
+		},
+		{
+			name:      "scientific notation rejected",
+			input:     "2.4e6",
+			expectErr: true,
+		},
+		{
+			name:      "out of range",
+			input:     "99999999999",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewFromJulianDayFunction(), types.StringValue(tc.input))
+
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
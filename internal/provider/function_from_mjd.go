@@ -0,0 +1,59 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &FromMJDFunction{}
+
+type FromMJDFunction struct{}
+
+func NewFromMJDFunction() function.Function {
+	return &FromMJDFunction{}
+}
+
+func (f *FromMJDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "from_mjd"
+}
+
+func (f *FromMJDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert Modified Julian Date to timestamp",
+		Description: "Converts a decimal Modified Julian Date into an RFC3339 timestamp in UTC. Fractional days are honoured and the result is rounded to the nearest millisecond.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "mjd",
+				Description: "Modified Julian Date as a decimal string (e.g., '60324.6875')",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FromMJDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	days, err := parseFractionalDays(value)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid Modified Julian Date: " + err.Error())
+		return
+	}
+
+	t, err := timeFromDays(days, mjdUnixEpoch)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid Modified Julian Date: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(t)))
+}
@@ -0,0 +1,73 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFromMJDFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "MJD epoch",
+			input:    "0",
+			expected: "1858-11-17T00:00:00Z",
+		},
+		{
+			name:     "whole day is midnight UTC",
+			input:    "60324",
+			expected: "2024-01-15T00:00:00Z",
+		},
+		{
+			name:     "fractional day",
+			input:    "60324.6875",
+			expected: "2024-01-15T16:30:00Z",
+		},
+		{
+			name:     "negative value",
+			input:    "-0.5",
+			expected: "1858-11-16T12:00:00Z",
+		},
+		{
+			name:      "empty string",
+			input:     "",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewFromMJDFunction(), types.StringValue(tc.input))
+
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &FromOrdinalDateFunction{}
+
+type FromOrdinalDateFunction struct{}
+
+func NewFromOrdinalDateFunction() function.Function {
+	return &FromOrdinalDateFunction{}
+}
+
+func (f *FromOrdinalDateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "from_ordinal_date"
+}
+
+func (f *FromOrdinalDateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert ordinal date to timestamp",
+		Description: "Converts an ISO 8601 ordinal date (YYYY-DDD or YYYYDDD) into an RFC3339 timestamp at the start of that day in UTC. An optional decimal fraction of the day (e.g., '2024-015.25') is added to the result, rounded to the nearest millisecond.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ordinal_date",
+				Description: "Ordinal date string (e.g., '2024-015' or '2024-015.5')",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FromOrdinalDateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ordinalDate string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ordinalDate))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseOrdinalDate(ordinalDate)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid ordinal date: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(t)))
+}
@@ -0,0 +1,88 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFromOrdinalDateFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "extended form",
+			input:    "2024-015",
+			expected: "2024-01-15T00:00:00Z",
+		},
+		{
+			name:     "basic form",
+			input:    "2024060",
+			expected: "2024-02-29T00:00:00Z",
+		},
+		{
+			name:     "fractional day",
+			input:    "2024-015.25",
+			expected: "2024-01-15T06:00:00Z",
+		},
+		{
+			name:     "fraction rounded to milliseconds",
+			input:    "2024-015.0000001",
+			expected: "2024-01-15T00:00:00.009Z",
+		},
+		{
+			name:     "day 366 of leap year",
+			input:    "2024-366",
+			expected: "2024-12-31T00:00:00Z",
+		},
+		{
+			name:      "day 366 of common year",
+			input:     "2023-366",
+			expectErr: true,
+		},
+		{
+			name:      "day zero",
+			input:     "2024-000",
+			expectErr: true,
+		},
+		{
+			name:      "calendar date",
+			input:     "2024-01-15",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewFromOrdinalDateFunction(), types.StringValue(tc.input))
+
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &GPSToUTCFunction{}
+
+type GPSToUTCFunction struct{}
+
+func NewGPSToUTCFunction() function.Function {
+	return &GPSToUTCFunction{}
+}
+
+func (f *GPSToUTCFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "gps_to_utc"
+}
+
+func (f *GPSToUTCFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert GPS time to UTC",
+		Description: "Converts a GPS clock reading, formatted as RFC3339, into a UTC timestamp by subtracting the leap seconds inserted since 1980-01-06. Readings that fall within an inserted leap second are returned with a seconds field of 60.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "GPS clock reading formatted as an RFC3339 timestamp",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "leap_seconds_list",
+			Description: "Optional contents of an IERS leap-seconds.list file to use instead of the built-in leap second table",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *GPSToUTCFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+	var leapSecondsList []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &leapSecondsList))
+	if resp.Error != nil {
+		return
+	}
+
+	table, err := leapSecondTableFromArgs(leapSecondsList)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid leap-seconds.list: " + err.Error())
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid RFC3339 timestamp: " + err.Error())
+		return
+	}
+
+	utc, leap, err := table.gpsToUTC(t)
+	if err != nil {
+		resp.Error = function.NewFuncError("Cannot convert GPS time to UTC: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatUTCTimestamp(utc, leap)))
+}
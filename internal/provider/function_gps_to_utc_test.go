@@ -0,0 +1,81 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGPSToUTCFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		overrides []string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "GPS epoch",
+			input:    "1980-01-06T00:00:00Z",
+			expected: "1980-01-06T00:00:00Z",
+		},
+		{
+			name:     "current offset",
+			input:    "2024-01-18T18:00:18Z",
+			expected: "2024-01-18T18:00:00Z",
+		},
+		{
+			name:     "inserted leap second",
+			input:    "2017-01-01T00:00:17Z",
+			expected: "2016-12-31T23:59:60Z",
+		},
+		{
+			name:      "override table",
+			input:     "2024-01-18T18:00:17Z",
+			overrides: []string{testLeapSecondsList},
+			expected:  "2024-01-18T18:00:00Z",
+		},
+		{
+			name:      "before GPS epoch",
+			input:     "1980-01-05T23:59:59Z",
+			expectErr: true,
+		},
+		{
+			name:      "multiple overrides",
+			input:     "2024-01-18T18:00:18Z",
+			overrides: []string{testLeapSecondsList, testLeapSecondsList},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewGPSToUTCFunction(), types.StringValue(tc.input), variadicStrings(tc.overrides...))
+
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
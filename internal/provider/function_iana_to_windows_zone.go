@@ -0,0 +1,56 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+// This file is not named function_iana_to_windows.go because the _windows
+// suffix would restrict it to Windows builds.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &IANAToWindowsFunction{}
+
+type IANAToWindowsFunction struct{}
+
+func NewIANAToWindowsFunction() function.Function {
+	return &IANAToWindowsFunction{}
+}
+
+func (f *IANAToWindowsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iana_to_windows"
+}
+
+func (f *IANAToWindowsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert an IANA time zone to a Windows time zone ID",
+		Description: "Returns the Windows time zone ID (e.g., 'W. Europe Standard Time') for an IANA time zone name or link name using the embedded CLDR windowsZones mapping.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "zone",
+				Description: "IANA time zone name (e.g., 'Europe/Berlin')",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *IANAToWindowsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var zone string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &zone))
+	if resp.Error != nil {
+		return
+	}
+
+	name, err := ianaToWindows(zone)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(name))
+}
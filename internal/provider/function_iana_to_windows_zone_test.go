@@ -0,0 +1,104 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+// This file is not named function_iana_to_windows_test.go because the
+// _windows suffix would restrict it to Windows builds.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIANAToWindowsFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:     "default zone",
+			input:    "Europe/Berlin",
+			expected: "W. Europe Standard Time",
+		},
+		{
+			name:     "territory zone",
+			input:    "America/Toronto",
+			expected: "Eastern Standard Time",
+		},
+		{
+			name:     "current name of legacy CLDR zone",
+			input:    "Asia/Kolkata",
+			expected: "India Standard Time",
+		},
+		{
+			name:     "legacy CLDR zone",
+			input:    "Asia/Calcutta",
+			expected: "India Standard Time",
+		},
+		{
+			name:     "link name",
+			input:    "US/Eastern",
+			expected: "Eastern Standard Time",
+		},
+		{
+			name:     "Indiana zone",
+			input:    "America/Indiana/Indianapolis",
+			expected: "US Eastern Standard Time",
+		},
+		{
+			name:     "UTC",
+			input:    "Etc/UTC",
+			expected: "UTC",
+		},
+		{
+			name:     "fixed offset zone",
+			input:    "Etc/GMT+12",
+			expected: "Dateline Standard Time",
+		},
+		{
+			name:      "unknown zone suggests close matches",
+			input:     "Europe/Berlinn",
+			expectErr: `did you mean "Europe/Berlin"`,
+		},
+		{
+			name:      "empty string",
+			input:     "",
+			expectErr: "unknown time zone",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewIANAToWindowsFunction(), types.StringValue(tc.input))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &IntervalContainsFunction{}
+
+type IntervalContainsFunction struct{}
+
+func NewIntervalContainsFunction() function.Function {
+	return &IntervalContainsFunction{}
+}
+
+func (f *IntervalContainsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interval_contains"
+}
+
+func (f *IntervalContainsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether an interval contains a timestamp or interval",
+		Description: "Returns true if a {start, end} interval of RFC3339 timestamps contains the value, which is either an RFC3339 timestamp or another interval. " +
+			"Intervals are half-open: a timestamp equal to start is contained but one equal to end is not, while an interval is contained when it lies entirely within, including one with the same end.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "interval",
+				Description:    "Interval object with RFC3339 start and end",
+				AttributeTypes: intervalAttributeTypes,
+			},
+			function.DynamicParameter{
+				Name:        "value",
+				Description: "RFC3339 timestamp or interval object to look for",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IntervalContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var model intervalModel
+	var value types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &model, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	iv, err := parseInterval(model)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid interval: " + err.Error())
+		return
+	}
+
+	var contains bool
+	switch v := value.UnderlyingValue().(type) {
+	case types.String:
+		var t time.Time
+		if t, err = parseTimestamp(v.ValueString()); err == nil {
+			contains = iv.contains(t)
+		}
+	case types.Object:
+		var o interval
+		if o, err = parseInterval(intervalModelFromObject(v)); err == nil {
+			contains = iv.containsInterval(o)
+		}
+	default:
+		err = errors.New("expected an RFC3339 timestamp string or an interval object")
+	}
+
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid value: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.BoolValue(contains))
+}
@@ -0,0 +1,111 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIntervalContainsFunction(t *testing.T) {
+	window := intervalValue("2024-01-15T09:00:00Z", "2024-01-15T12:00:00Z")
+
+	testCases := []struct {
+		name      string
+		value     attr.Value
+		expected  bool
+		expectErr string
+	}{
+		{
+			name:     "timestamp inside",
+			value:    types.StringValue("2024-01-15T10:00:00Z"),
+			expected: true,
+		},
+		{
+			name:     "start is included",
+			value:    types.StringValue("2024-01-15T09:00:00Z"),
+			expected: true,
+		},
+		{
+			name:     "end is excluded",
+			value:    types.StringValue("2024-01-15T12:00:00Z"),
+			expected: false,
+		},
+		{
+			name:     "timestamp with different offset",
+			value:    types.StringValue("2024-01-15T12:30:00+01:00"),
+			expected: true,
+		},
+		{
+			name:     "interval inside with the same end",
+			value:    intervalValue("2024-01-15T10:00:00Z", "2024-01-15T12:00:00Z"),
+			expected: true,
+		},
+		{
+			name:     "interval extending past the end",
+			value:    intervalValue("2024-01-15T10:00:00Z", "2024-01-15T12:00:01Z"),
+			expected: false,
+		},
+		{
+			name: "interval object with extra attributes",
+			value: types.ObjectValueMust(
+				map[string]attr.Type{"name": types.StringType, "start": types.StringType, "end": types.StringType},
+				map[string]attr.Value{
+					"name":  types.StringValue("patch window"),
+					"start": types.StringValue("2024-01-15T10:00:00Z"),
+					"end":   types.StringValue("2024-01-15T11:00:00Z"),
+				},
+			),
+			expected: true,
+		},
+		{
+			name:      "object without end",
+			value:     types.ObjectValueMust(map[string]attr.Type{"start": types.StringType}, map[string]attr.Value{"start": types.StringValue("2024-01-15T10:00:00Z")}),
+			expectErr: "start and end must both be set",
+		},
+		{
+			name:      "number",
+			value:     types.NumberNull(),
+			expectErr: "expected an RFC3339 timestamp string or an interval object",
+		},
+		{
+			name:      "invalid timestamp",
+			value:     types.StringValue("noon"),
+			expectErr: "Invalid value",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewIntervalContainsFunction(), window, types.DynamicValue(tc.value))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+
+			got, ok := result.(types.Bool)
+			if !ok {
+				t.Errorf("Expected types.Bool, got %T", result)
+				return
+			}
+
+			if got.ValueBool() != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got.ValueBool())
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &IntervalGapsFunction{}
+
+type IntervalGapsFunction struct{}
+
+func NewIntervalGapsFunction() function.Function {
+	return &IntervalGapsFunction{}
+}
+
+func (f *IntervalGapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interval_gaps"
+}
+
+func (f *IntervalGapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Find the gaps between intervals",
+		Description: "Returns the instants not covered by a list of half-open {start, end} intervals of RFC3339 timestamps, as a list of intervals sorted by start. " +
+			"Without bounds the gaps run from the earliest start to the latest end; an optional bounds interval also reports gaps before the first and after the last interval within it.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "intervals",
+				Description: "List of interval objects with RFC3339 start and end",
+				ElementType: intervalObjectType,
+			},
+		},
+		VariadicParameter: function.ObjectParameter{
+			Name:           "bounds",
+			Description:    "Optional interval object limiting where gaps are reported",
+			AttributeTypes: intervalAttributeTypes,
+		},
+		Return: function.ListReturn{
+			ElementType: intervalObjectType,
+		},
+	}
+}
+
+func (f *IntervalGapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var models, boundsModels []intervalModel
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &models, &boundsModels))
+	if resp.Error != nil {
+		return
+	}
+
+	intervals, err := parseIntervals(models)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid intervals: " + err.Error())
+		return
+	}
+
+	var bounds *interval
+	switch len(boundsModels) {
+	case 0:
+	case 1:
+		b, err := parseInterval(boundsModels[0])
+		if err != nil {
+			resp.Error = function.NewFuncError("Invalid bounds: " + err.Error())
+			return
+		}
+		bounds = &b
+	default:
+		resp.Error = function.NewFuncError("Invalid bounds: at most one bounds interval may be supplied")
+		return
+	}
+
+	resp.Result = function.NewResultData(intervalListValue(intervalGaps(intervals, bounds)))
+}
@@ -0,0 +1,100 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIntervalGapsFunction(t *testing.T) {
+	windows := intervalList(
+		"2024-01-15T13:00:00Z/2024-01-15T14:00:00Z",
+		"2024-01-15T09:00:00Z/2024-01-15T10:00:00Z",
+		"2024-01-15T10:00:00Z/2024-01-15T11:00:00Z",
+	)
+
+	testCases := []struct {
+		name      string
+		intervals types.List
+		bounds    []attr.Value
+		expected  []string
+		expectErr string
+	}{
+		{
+			name:      "gaps between intervals",
+			intervals: windows,
+			expected:  []string{"2024-01-15T11:00:00Z/2024-01-15T13:00:00Z"},
+		},
+		{
+			name:      "gaps within bounds",
+			intervals: windows,
+			bounds:    []attr.Value{intervalValue("2024-01-15T08:00:00Z", "2024-01-15T18:00:00Z")},
+			expected: []string{
+				"2024-01-15T08:00:00Z/2024-01-15T09:00:00Z",
+				"2024-01-15T11:00:00Z/2024-01-15T13:00:00Z",
+				"2024-01-15T14:00:00Z/2024-01-15T18:00:00Z",
+			},
+		},
+		{
+			name:      "bounds narrower than intervals",
+			intervals: windows,
+			bounds:    []attr.Value{intervalValue("2024-01-15T09:30:00Z", "2024-01-15T12:00:00Z")},
+			expected:  []string{"2024-01-15T11:00:00Z/2024-01-15T12:00:00Z"},
+		},
+		{
+			name:      "no intervals without bounds",
+			intervals: intervalList(),
+			expected:  nil,
+		},
+		{
+			name:      "no intervals within bounds",
+			intervals: intervalList(),
+			bounds:    []attr.Value{intervalValue("2024-01-15T08:00:00Z", "2024-01-15T18:00:00Z")},
+			expected:  []string{"2024-01-15T08:00:00Z/2024-01-15T18:00:00Z"},
+		},
+		{
+			name:      "too many bounds",
+			intervals: windows,
+			bounds: []attr.Value{
+				intervalValue("2024-01-15T08:00:00Z", "2024-01-15T18:00:00Z"),
+				intervalValue("2024-01-16T08:00:00Z", "2024-01-16T18:00:00Z"),
+			},
+			expectErr: "at most one bounds interval",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			boundTypes := make([]attr.Type, len(tc.bounds))
+			for i := range tc.bounds {
+				boundTypes[i] = intervalObjectType
+			}
+
+			result, err := runFunction(t, NewIntervalGapsFunction(), tc.intervals, types.TupleValueMust(boundTypes, tc.bounds))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+
+			if got := intervalListStrings(t, result); !slices.Equal(got, tc.expected) {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &IntervalIntersectionFunction{}
+
+type IntervalIntersectionFunction struct{}
+
+func NewIntervalIntersectionFunction() function.Function {
+	return &IntervalIntersectionFunction{}
+}
+
+func (f *IntervalIntersectionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interval_intersection"
+}
+
+func (f *IntervalIntersectionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Get the overlap of two intervals",
+		Description: "Returns the {start, end} interval of instants in both of two half-open intervals of RFC3339 timestamps, or null if they do not overlap. " +
+			"Intervals that only meet end to end do not overlap.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "a",
+				Description:    "Interval object with RFC3339 start and end",
+				AttributeTypes: intervalAttributeTypes,
+			},
+			function.ObjectParameter{
+				Name:           "b",
+				Description:    "Interval object with RFC3339 start and end",
+				AttributeTypes: intervalAttributeTypes,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: intervalAttributeTypes,
+		},
+	}
+}
+
+func (f *IntervalIntersectionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var modelA, modelB intervalModel
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &modelA, &modelB))
+	if resp.Error != nil {
+		return
+	}
+
+	a, err := parseInterval(modelA)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid interval a: " + err.Error())
+		return
+	}
+
+	b, err := parseInterval(modelB)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid interval b: " + err.Error())
+		return
+	}
+
+	intersection, ok := a.intersection(b)
+	if !ok {
+		resp.Result = function.NewResultData(types.ObjectNull(intervalAttributeTypes))
+		return
+	}
+
+	resp.Result = function.NewResultData(intersection.value())
+}
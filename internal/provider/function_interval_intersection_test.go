@@ -0,0 +1,91 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIntervalIntersectionFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		a         types.Object
+		b         types.Object
+		expected  string
+		expectErr string
+	}{
+		{
+			name:     "overlapping",
+			a:        intervalValue("2024-01-15T09:00:00Z", "2024-01-15T12:00:00Z"),
+			b:        intervalValue("2024-01-15T11:00:00Z", "2024-01-15T13:00:00Z"),
+			expected: "2024-01-15T11:00:00Z/2024-01-15T12:00:00Z",
+		},
+		{
+			name:     "contained",
+			a:        intervalValue("2024-01-01T00:00:00Z", "2024-02-01T00:00:00Z"),
+			b:        intervalValue("2024-01-15T11:00:00Z", "2024-01-15T13:00:00Z"),
+			expected: "2024-01-15T11:00:00Z/2024-01-15T13:00:00Z",
+		},
+		{
+			name:     "endpoints keep their offsets",
+			a:        intervalValue("2024-01-15T09:00:00Z", "2024-01-15T12:00:00Z"),
+			b:        intervalValue("2024-01-15T11:00:00+01:00", "2024-01-15T14:00:00+01:00"),
+			expected: "2024-01-15T11:00:00+01:00/2024-01-15T12:00:00Z",
+		},
+		{
+			name:     "meeting end to end is null",
+			a:        intervalValue("2024-01-15T09:00:00Z", "2024-01-15T12:00:00Z"),
+			b:        intervalValue("2024-01-15T12:00:00Z", "2024-01-15T13:00:00Z"),
+			expected: "",
+		},
+		{
+			name:      "invalid interval",
+			a:         intervalValue("2024-01-15T12:00:00Z", "2024-01-15T09:00:00Z"),
+			b:         intervalValue("2024-01-15T12:00:00Z", "2024-01-15T13:00:00Z"),
+			expectErr: "Invalid interval a",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewIntervalIntersectionFunction(), tc.a, tc.b)
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+
+			got, ok := result.(types.Object)
+			if !ok {
+				t.Errorf("Expected types.Object, got %T", result)
+				return
+			}
+
+			if tc.expected == "" {
+				if !got.IsNull() {
+					t.Errorf("Expected null, got %s", got)
+				}
+				return
+			}
+
+			gotIntervals := intervalListStrings(t, types.ListValueMust(intervalObjectType, []attr.Value{got}))
+			if gotIntervals[0] != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, gotIntervals[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &IntervalOverlapsFunction{}
+
+type IntervalOverlapsFunction struct{}
+
+func NewIntervalOverlapsFunction() function.Function {
+	return &IntervalOverlapsFunction{}
+}
+
+func (f *IntervalOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interval_overlaps"
+}
+
+func (f *IntervalOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether two intervals overlap",
+		Description: "Returns true if two {start, end} intervals of RFC3339 timestamps share at least one instant. " +
+			"Intervals are half-open, including start but not end, so an interval ending exactly when another starts does not overlap it, and empty intervals overlap nothing.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "a",
+				Description:    "Interval object with RFC3339 start and end",
+				AttributeTypes: intervalAttributeTypes,
+			},
+			function.ObjectParameter{
+				Name:           "b",
+				Description:    "Interval object with RFC3339 start and end",
+				AttributeTypes: intervalAttributeTypes,
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IntervalOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var modelA, modelB intervalModel
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &modelA, &modelB))
+	if resp.Error != nil {
+		return
+	}
+
+	a, err := parseInterval(modelA)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid interval a: " + err.Error())
+		return
+	}
+
+	b, err := parseInterval(modelB)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid interval b: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.BoolValue(a.overlaps(b)))
+}
@@ -0,0 +1,94 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIntervalOverlapsFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		a         types.Object
+		b         types.Object
+		expected  bool
+		expectErr string
+	}{
+		{
+			name:     "overlapping",
+			a:        intervalValue("2024-01-15T09:00:00Z", "2024-01-15T12:00:00Z"),
+			b:        intervalValue("2024-01-15T11:00:00Z", "2024-01-15T13:00:00Z"),
+			expected: true,
+		},
+		{
+			name:     "meeting end to end",
+			a:        intervalValue("2024-01-15T09:00:00Z", "2024-01-15T12:00:00Z"),
+			b:        intervalValue("2024-01-15T12:00:00Z", "2024-01-15T13:00:00Z"),
+			expected: false,
+		},
+		{
+			name:     "mixed offsets compare by instant",
+			a:        intervalValue("2024-01-15T09:00:00Z", "2024-01-15T12:00:00Z"),
+			b:        intervalValue("2024-01-15T12:30:00+01:00", "2024-01-15T14:00:00+01:00"),
+			expected: true,
+		},
+		{
+			name:     "disjoint",
+			a:        intervalValue("2024-01-15T09:00:00Z", "2024-01-15T10:00:00Z"),
+			b:        intervalValue("2024-01-16T09:00:00Z", "2024-01-16T10:00:00Z"),
+			expected: false,
+		},
+		{
+			name:     "empty interval overlaps nothing",
+			a:        intervalValue("2024-01-15T09:00:00Z", "2024-01-15T12:00:00Z"),
+			b:        intervalValue("2024-01-15T10:00:00Z", "2024-01-15T10:00:00Z"),
+			expected: false,
+		},
+		{
+			name:      "end before start",
+			a:         intervalValue("2024-01-15T12:00:00Z", "2024-01-15T09:00:00Z"),
+			b:         intervalValue("2024-01-15T10:00:00Z", "2024-01-15T11:00:00Z"),
+			expectErr: "is before start",
+		},
+		{
+			name:      "invalid timestamp",
+			a:         intervalValue("2024-01-15T09:00:00Z", "2024-01-15T12:00:00Z"),
+			b:         intervalValue("yesterday", "2024-01-15T11:00:00Z"),
+			expectErr: "Invalid interval b: invalid start",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewIntervalOverlapsFunction(), tc.a, tc.b)
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+
+			got, ok := result.(types.Bool)
+			if !ok {
+				t.Errorf("Expected types.Bool, got %T", result)
+				return
+			}
+
+			if got.ValueBool() != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got.ValueBool())
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &IntervalSubtractFunction{}
+
+type IntervalSubtractFunction struct{}
+
+func NewIntervalSubtractFunction() function.Function {
+	return &IntervalSubtractFunction{}
+}
+
+func (f *IntervalSubtractFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interval_subtract"
+}
+
+func (f *IntervalSubtractFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Remove intervals from a list of intervals",
+		Description: "Returns the instants covered by a list of {start, end} intervals of RFC3339 timestamps but not by any interval in a second list, " +
+			"as a list of non-overlapping intervals sorted by start. Intervals are half-open, so removing [10:00, 11:00) from [09:00, 12:00) leaves [09:00, 10:00) and [11:00, 12:00).",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "intervals",
+				Description: "List of interval objects with RFC3339 start and end",
+				ElementType: intervalObjectType,
+			},
+			function.ListParameter{
+				Name:        "remove",
+				Description: "List of interval objects to remove",
+				ElementType: intervalObjectType,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: intervalObjectType,
+		},
+	}
+}
+
+func (f *IntervalSubtractFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var models, removeModels []intervalModel
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &models, &removeModels))
+	if resp.Error != nil {
+		return
+	}
+
+	intervals, err := parseIntervals(models)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid intervals: " + err.Error())
+		return
+	}
+
+	remove, err := parseIntervals(removeModels)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid remove intervals: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(intervalListValue(subtractIntervals(intervals, remove)))
+}
@@ -0,0 +1,90 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIntervalSubtractFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		intervals types.List
+		remove    types.List
+		expected  []string
+		expectErr string
+	}{
+		{
+			name:      "splits an interval",
+			intervals: intervalList("2024-01-15T09:00:00Z/2024-01-15T12:00:00Z"),
+			remove:    intervalList("2024-01-15T10:00:00Z/2024-01-15T11:00:00Z"),
+			expected: []string{
+				"2024-01-15T09:00:00Z/2024-01-15T10:00:00Z",
+				"2024-01-15T11:00:00Z/2024-01-15T12:00:00Z",
+			},
+		},
+		{
+			name: "removes freeze periods from several windows",
+			intervals: intervalList(
+				"2024-12-01T00:00:00Z/2024-12-08T00:00:00Z",
+				"2024-12-15T00:00:00Z/2025-01-15T00:00:00Z",
+			),
+			remove: intervalList(
+				"2024-12-20T00:00:00Z/2025-01-02T00:00:00Z",
+				"2024-12-05T00:00:00Z/2024-12-10T00:00:00Z",
+			),
+			expected: []string{
+				"2024-12-01T00:00:00Z/2024-12-05T00:00:00Z",
+				"2024-12-15T00:00:00Z/2024-12-20T00:00:00Z",
+				"2025-01-02T00:00:00Z/2025-01-15T00:00:00Z",
+			},
+		},
+		{
+			name:      "removing everything",
+			intervals: intervalList("2024-01-15T09:00:00Z/2024-01-15T12:00:00Z"),
+			remove:    intervalList("2024-01-15T08:00:00Z/2024-01-15T12:00:00Z"),
+			expected:  nil,
+		},
+		{
+			name:      "nothing to remove",
+			intervals: intervalList("2024-01-15T09:00:00Z/2024-01-15T12:00:00Z"),
+			remove:    intervalList(),
+			expected:  []string{"2024-01-15T09:00:00Z/2024-01-15T12:00:00Z"},
+		},
+		{
+			name:      "invalid remove interval",
+			intervals: intervalList("2024-01-15T09:00:00Z/2024-01-15T12:00:00Z"),
+			remove:    intervalList("2024-01-15T10:00:00Z/later"),
+			expectErr: "Invalid remove intervals: element 0: invalid end",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewIntervalSubtractFunction(), tc.intervals, tc.remove)
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+
+			if got := intervalListStrings(t, result); !slices.Equal(got, tc.expected) {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
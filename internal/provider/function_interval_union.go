@@ -0,0 +1,57 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &IntervalUnionFunction{}
+
+type IntervalUnionFunction struct{}
+
+func NewIntervalUnionFunction() function.Function {
+	return &IntervalUnionFunction{}
+}
+
+func (f *IntervalUnionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "interval_union"
+}
+
+func (f *IntervalUnionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merge a list of intervals",
+		Description: "Returns the union of a list of {start, end} intervals of RFC3339 timestamps as a list of non-overlapping intervals sorted by start. " +
+			"Intervals are half-open, so those that overlap or meet end to end are merged into one, and empty intervals are dropped.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "intervals",
+				Description: "List of interval objects with RFC3339 start and end",
+				ElementType: intervalObjectType,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: intervalObjectType,
+		},
+	}
+}
+
+func (f *IntervalUnionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var models []intervalModel
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &models))
+	if resp.Error != nil {
+		return
+	}
+
+	intervals, err := parseIntervals(models)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid intervals: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(intervalListValue(mergeIntervals(intervals)))
+}
@@ -0,0 +1,80 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIntervalUnionFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		intervals types.List
+		expected  []string
+		expectErr string
+	}{
+		{
+			name: "merges overlapping and sorts",
+			intervals: intervalList(
+				"2024-01-15T14:00:00Z/2024-01-15T15:00:00Z",
+				"2024-01-15T09:00:00Z/2024-01-15T11:00:00Z",
+				"2024-01-15T10:00:00Z/2024-01-15T12:00:00Z",
+			),
+			expected: []string{
+				"2024-01-15T09:00:00Z/2024-01-15T12:00:00Z",
+				"2024-01-15T14:00:00Z/2024-01-15T15:00:00Z",
+			},
+		},
+		{
+			name: "merges intervals meeting end to end",
+			intervals: intervalList(
+				"2024-01-15T09:00:00Z/2024-01-15T10:00:00Z",
+				"2024-01-15T11:00:00+01:00/2024-01-15T12:00:00+01:00",
+			),
+			expected: []string{
+				"2024-01-15T09:00:00Z/2024-01-15T12:00:00+01:00",
+			},
+		},
+		{
+			name: "drops empty intervals",
+			intervals: intervalList(
+				"2024-01-15T09:00:00Z/2024-01-15T09:00:00Z",
+			),
+			expected: nil,
+		},
+		{
+			name:      "invalid element",
+			intervals: intervalList("2024-01-15T09:00:00Z/2024-01-15T10:00:00Z", "2024-01-15T10:00:00Z/2024-01-15T09:00:00Z"),
+			expectErr: "element 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewIntervalUnionFunction(), tc.intervals)
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+				return
+			}
+
+			if got := intervalListStrings(t, result); !slices.Equal(got, tc.expected) {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &IsValidTimezoneFunction{}
+
+type IsValidTimezoneFunction struct{}
+
+func NewIsValidTimezoneFunction() function.Function {
+	return &IsValidTimezoneFunction{}
+}
+
+func (f *IsValidTimezoneFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_valid_timezone"
+}
+
+func (f *IsValidTimezoneFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a string is an IANA time zone name",
+		Description: "Returns true if the name is a time zone in the IANA database embedded in the provider, including link names such as 'US/Eastern' and 'UTC'. " +
+			"Returns false instead of an error for anything else, so it can be used in variable validation blocks. " +
+			"The empty string and 'Local' are not valid because they depend on the machine running Terraform.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "Time zone name to check (e.g., 'Europe/London')",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IsValidTimezoneFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	_, err := loadLocation(name)
+
+	resp.Result = function.NewResultData(types.BoolValue(err == nil))
+}
@@ -0,0 +1,74 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsValidTimezoneFunction(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "canonical zone",
+			input:    "Europe/London",
+			expected: true,
+		},
+		{
+			name:     "link name",
+			input:    "US/Eastern",
+			expected: true,
+		},
+		{
+			name:     "UTC",
+			input:    "UTC",
+			expected: true,
+		},
+		{
+			name:     "unknown zone",
+			input:    "Europe/Atlantis",
+			expected: false,
+		},
+		{
+			name:     "wrong case",
+			input:    "europe/london",
+			expected: false,
+		},
+		{
+			name:     "empty",
+			input:    "",
+			expected: false,
+		},
+		{
+			name:     "local",
+			input:    "Local",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewIsValidTimezoneFunction(), types.StringValue(tc.input))
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.Bool)
+			if !ok {
+				t.Errorf("Expected types.Bool, got %T", result)
+				return
+			}
+
+			if got.ValueBool() != tc.expected {
+				t.Errorf("Expected %t for input %q, got %t", tc.expected, tc.input, got.ValueBool())
+			}
+		})
+	}
+}
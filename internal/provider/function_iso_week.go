@@ -0,0 +1,72 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ISOWeekFunction{}
+
+type ISOWeekFunction struct{}
+
+func NewISOWeekFunction() function.Function {
+	return &ISOWeekFunction{}
+}
+
+func (f *ISOWeekFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iso_week"
+}
+
+func (f *ISOWeekFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Get the ISO 8601 week date of a timestamp",
+		Description: "Returns a JSON object with the ISO 8601 iso_year, week (1-53), weekday_iso (1 for Monday to 7 for Sunday) and week_date (e.g., '2025-W01-2') of an RFC3339 timestamp, using the timestamp's own offset. " +
+			"Between December 29th and January 3rd the ISO year can differ from the calendar year.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "RFC3339 formatted timestamp",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ISOWeekFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamp: " + err.Error())
+		return
+	}
+
+	year, week := t.ISOWeek()
+	weekday := (int(t.Weekday())+6)%7 + 1
+
+	result, err := json.Marshal(map[string]string{
+		"iso_year":    strconv.Itoa(year),
+		"week":        strconv.Itoa(week),
+		"weekday_iso": strconv.Itoa(weekday),
+		"week_date":   fmt.Sprintf("%04d-W%02d-%d", year, week, weekday),
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to encode ISO week: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(string(result)))
+}
@@ -0,0 +1,71 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ISOWeekStartFunction{}
+
+type ISOWeekStartFunction struct{}
+
+func NewISOWeekStartFunction() function.Function {
+	return &ISOWeekStartFunction{}
+}
+
+func (f *ISOWeekStartFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iso_week_start"
+}
+
+func (f *ISOWeekStartFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Get the start of an ISO 8601 week",
+		Description: "Returns the RFC3339 timestamp of midnight UTC on the Monday that starts the given week of an ISO 8601 week-numbering year. Week 53 is only accepted in years that have one.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "iso_year",
+				Description: "ISO week-numbering year (e.g., '2025')",
+			},
+			function.StringParameter{
+				Name:        "week",
+				Description: "ISO week number from 1 to 53 (e.g., '1')",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ISOWeekStartFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var yearValue, weekValue string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &yearValue, &weekValue))
+	if resp.Error != nil {
+		return
+	}
+
+	year, err := strconv.Atoi(yearValue)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid ISO year: " + strconv.Quote(yearValue) + " is not an integer")
+		return
+	}
+
+	week, err := strconv.Atoi(weekValue)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid week: " + strconv.Quote(weekValue) + " is not an integer")
+		return
+	}
+
+	start, err := isoWeekStart(year, week)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid ISO week: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(start)))
+}
@@ -0,0 +1,100 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestISOWeekStartFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		year      string
+		week      string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:     "week 1 starting in the previous calendar year",
+			year:     "2025",
+			week:     "1",
+			expected: "2024-12-30T00:00:00Z",
+		},
+		{
+			name:     "week 1 starting on January 1st",
+			year:     "2024",
+			week:     "1",
+			expected: "2024-01-01T00:00:00Z",
+		},
+		{
+			name:     "mid year",
+			year:     "2024",
+			week:     "3",
+			expected: "2024-01-15T00:00:00Z",
+		},
+		{
+			name:     "week 53 in a long year",
+			year:     "2020",
+			week:     "53",
+			expected: "2020-12-28T00:00:00Z",
+		},
+		{
+			name:      "week 53 in a short year",
+			year:      "2024",
+			week:      "53",
+			expectErr: "has no week 53",
+		},
+		{
+			name:      "week 0",
+			year:      "2024",
+			week:      "0",
+			expectErr: "has no week 0",
+		},
+		{
+			name:      "non-integer week",
+			year:      "2024",
+			week:      "W03",
+			expectErr: "Invalid week",
+		},
+		{
+			name:      "year out of range",
+			year:      "10000",
+			week:      "1",
+			expectErr: "outside the supported range",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewISOWeekStartFunction(), types.StringValue(tc.year), types.StringValue(tc.week))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for %s-W%s, but got none", tc.year, tc.week)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for %s-W%s: %v", tc.year, tc.week, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
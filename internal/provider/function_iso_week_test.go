@@ -0,0 +1,109 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestISOWeekFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expected  map[string]string
+		expectErr bool
+	}{
+		{
+			name:  "mid January",
+			input: "2024-01-17T10:00:00Z",
+			expected: map[string]string{
+				"iso_year":    "2024",
+				"week":        "3",
+				"weekday_iso": "3",
+				"week_date":   "2024-W03-3",
+			},
+		},
+		{
+			name:  "late December in the next ISO year",
+			input: "2024-12-30T10:00:00Z",
+			expected: map[string]string{
+				"iso_year":    "2025",
+				"week":        "1",
+				"weekday_iso": "1",
+				"week_date":   "2025-W01-1",
+			},
+		},
+		{
+			name:  "early January in the previous ISO year",
+			input: "2021-01-03T10:00:00Z",
+			expected: map[string]string{
+				"iso_year":    "2020",
+				"week":        "53",
+				"weekday_iso": "7",
+				"week_date":   "2020-W53-7",
+			},
+		},
+		{
+			name:  "January 1st on a Thursday",
+			input: "2026-01-01T00:00:00Z",
+			expected: map[string]string{
+				"iso_year": "2026",
+				"week":     "1",
+			},
+		},
+		{
+			name:  "uses the timestamp offset",
+			input: "2024-12-29T23:30:00-05:00",
+			expected: map[string]string{
+				"iso_year":    "2024",
+				"week":        "52",
+				"weekday_iso": "7",
+			},
+		},
+		{
+			name:      "invalid timestamp",
+			input:     "2024-W03-3",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewISOWeekFunction(), types.StringValue(tc.input))
+
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			var actual map[string]string
+			if err := json.Unmarshal([]byte(got.ValueString()), &actual); err != nil {
+				t.Errorf("Failed to parse result JSON: %v", err)
+				return
+			}
+
+			for key, expectedValue := range tc.expected {
+				if actual[key] != expectedValue {
+					t.Errorf("Expected %s=%q, got %s=%q", key, expectedValue, key, actual[key])
+				}
+			}
+		})
+	}
+}
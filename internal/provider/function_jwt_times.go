@@ -0,0 +1,177 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jwtTimeClaims are the registered JWT claims holding a NumericDate, in the
+// order they are checked.
+var jwtTimeClaims = []string{"iat", "nbf", "exp"}
+
+var _ function.Function = &JWTTimesFunction{}
+
+type JWTTimesFunction struct{}
+
+func NewJWTTimesFunction() function.Function {
+	return &JWTTimesFunction{}
+}
+
+func (f *JWTTimesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jwt_times"
+}
+
+func (f *JWTTimesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Get the iat, nbf and exp claims of a JWT",
+		Description: "Returns a JSON object with the iat, nbf and exp claims of a JSON Web Token formatted as RFC3339 in UTC, omitting claims the token does not have; claims outside the years 0 to 9999 are an error. " +
+			"When a reference timestamp is given as the optional argument and the token has an exp claim, the object also has remaining_seconds, the whole seconds from reference to exp, negative once the token has expired. " +
+			"The payload is base64url decoded offline without verifying the signature, so the result must not be used to decide whether to trust a token. " +
+			"Only the time claims are returned, and errors never include the token or its contents; when the token is a sensitive value Terraform marks the result sensitive too. " +
+			"The reference may be " + timestampFormatSummary + "; dates without a time are midnight UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "token",
+				Description: "Signed JWT in compact form (header.payload.signature)",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "options",
+			Description: "Optional reference timestamp to measure remaining_seconds from, usually timestamp()",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *JWTTimesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var token string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &token, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(options) > 1 {
+		resp.Error = function.NewFuncError("Invalid options: expected at most a reference timestamp")
+		return
+	}
+
+	var reference *time.Time
+	if len(options) == 1 {
+		t, err := parseAnyTimestamp(options[0])
+		if err != nil {
+			resp.Error = function.NewFuncError("Invalid reference timestamp: " + err.Error())
+			return
+		}
+		reference = &t
+	}
+
+	claims, err := jwtClaimTimes(token)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid token: " + err.Error())
+		return
+	}
+
+	times := make(map[string]string, len(claims)+1)
+	for name, t := range claims {
+		times[name] = formatTimestamp(t.UTC())
+	}
+	if exp, ok := claims["exp"]; ok && reference != nil {
+		times["remaining_seconds"] = strconv.FormatInt(secondsBetween(*reference, exp), 10)
+	}
+
+	result, err := json.Marshal(times)
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to encode token times: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(string(result)))
+}
+
+// jwtClaimTimes decodes the payload of a compact JWS without verifying it and
+// returns the time claims it contains. Errors describe only the structure of
+// the token so that it is never echoed back.
+func jwtClaimTimes(token string) (map[string]time.Time, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	switch {
+	case len(parts) == 5:
+		return nil, errors.New("encrypted tokens (JWE) are not supported")
+	case len(parts) != 3:
+		return nil, fmt.Errorf("expected 3 dot-separated parts, got %d", len(parts))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, errors.New("payload is not base64url encoded")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	var claims map[string]any
+	if err := decoder.Decode(&claims); err != nil || claims == nil {
+		return nil, errors.New("payload is not a JSON object")
+	}
+
+	times := make(map[string]time.Time)
+	for _, name := range jwtTimeClaims {
+		value, ok := claims[name]
+		if !ok {
+			continue
+		}
+
+		number, ok := value.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("claim %s is not a NumericDate", name)
+		}
+
+		t, err := numericDate(number)
+		if err != nil {
+			return nil, fmt.Errorf("claim %s: %w", name, err)
+		}
+		times[name] = t
+	}
+
+	return times, nil
+}
+
+// numericDate converts a JWT NumericDate, seconds since the Unix epoch that
+// may have a fraction, to a time, rejecting instants outside the years 0 to
+// 9999 that RFC3339 can represent.
+func numericDate(number json.Number) (time.Time, error) {
+	earliest := time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+	end := time.Date(10000, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+
+	outOfRange := errors.New("NumericDate is outside the years 0 to 9999")
+
+	if seconds, err := number.Int64(); err == nil {
+		if seconds < earliest || seconds >= end {
+			return time.Time{}, outOfRange
+		}
+		return time.Unix(seconds, 0), nil
+	}
+
+	seconds, err := number.Float64()
+	if err != nil || math.IsNaN(seconds) || seconds < float64(earliest) || seconds >= float64(end) {
+		return time.Time{}, outOfRange
+	}
+
+	whole, fraction := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(math.Round(fraction*float64(time.Second)))), nil
+}
@@ -0,0 +1,177 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testJWT builds an unsigned compact JWT with the given JSON payload.
+func testJWT(payload string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + encode([]byte(payload)) + "." + encode([]byte("signature"))
+}
+
+func TestJWTTimesFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		token     string
+		options   []string
+		expected  map[string]string
+		expectErr string
+	}{
+		{
+			name:  "all time claims",
+			token: testJWT(`{"sub":"svc-deploy","iat":1705314600,"nbf":1705314600,"exp":1705318200}`),
+			expected: map[string]string{
+				"iat": "2024-01-15T10:30:00Z",
+				"nbf": "2024-01-15T10:30:00Z",
+				"exp": "2024-01-15T11:30:00Z",
+			},
+		},
+		{
+			name:    "remaining lifetime",
+			token:   testJWT(`{"iat":1705314600,"exp":1705318200}`),
+			options: []string{"2024-01-15T11:00:00Z"},
+			expected: map[string]string{
+				"iat":               "2024-01-15T10:30:00Z",
+				"exp":               "2024-01-15T11:30:00Z",
+				"remaining_seconds": "1800",
+			},
+		},
+		{
+			name:    "expired token",
+			token:   testJWT(`{"exp":1705318200}`),
+			options: []string{"2024-01-15T13:00:00+01:00"},
+			expected: map[string]string{
+				"exp":               "2024-01-15T11:30:00Z",
+				"remaining_seconds": "-1800",
+			},
+		},
+		{
+			name:    "reference without exp",
+			token:   testJWT(`{"iat":1705314600}`),
+			options: []string{"2024-01-15T11:00:00Z"},
+			expected: map[string]string{
+				"iat": "2024-01-15T10:30:00Z",
+			},
+		},
+		{
+			name:     "no time claims",
+			token:    testJWT(`{"sub":"svc-deploy"}`),
+			expected: map[string]string{},
+		},
+		{
+			name:  "fractional NumericDate",
+			token: testJWT(`{"exp":1705318200.25}`),
+			expected: map[string]string{
+				"exp": "2024-01-15T11:30:00.25Z",
+			},
+		},
+		{
+			name:  "padded payload and surrounding whitespace",
+			token: " " + strings.Replace(testJWT(`{"exp":1705318200}`), ".", "==.", 1) + "\n",
+			expected: map[string]string{
+				"exp": "2024-01-15T11:30:00Z",
+			},
+		},
+		{
+			name:    "exp beyond the range of a duration",
+			token:   testJWT(`{"exp":253402300799}`),
+			options: []string{"2024-01-01"},
+			expected: map[string]string{
+				"exp":               "9999-12-31T23:59:59Z",
+				"remaining_seconds": "251698233599",
+			},
+		},
+		{
+			name:      "exp after year 9999",
+			token:     testJWT(`{"exp":253402300800}`),
+			expectErr: "claim exp: NumericDate is outside the years 0 to 9999",
+		},
+		{
+			name:      "fractional exp after year 9999",
+			token:     testJWT(`{"exp":1e300}`),
+			expectErr: "claim exp: NumericDate is outside the years 0 to 9999",
+		},
+		{
+			name:      "iat before year 0",
+			token:     testJWT(`{"iat":-62167219201}`),
+			expectErr: "claim iat: NumericDate is outside the years 0 to 9999",
+		},
+		{
+			name:      "not a JWT",
+			token:     "not-a-token",
+			expectErr: "expected 3 dot-separated parts, got 1",
+		},
+		{
+			name:      "encrypted token",
+			token:     "a.b.c.d.e",
+			expectErr: "JWE",
+		},
+		{
+			name:      "payload not base64url",
+			token:     "e30.!!!.sig",
+			expectErr: "payload is not base64url encoded",
+		},
+		{
+			name:      "payload not an object",
+			token:     testJWT(`["secret-value"]`),
+			expectErr: "payload is not a JSON object",
+		},
+		{
+			name:      "claim not a number",
+			token:     testJWT(`{"exp":"secret-value"}`),
+			expectErr: "claim exp is not a NumericDate",
+		},
+		{
+			name:      "invalid reference",
+			token:     testJWT(`{"exp":1705318200}`),
+			options:   []string{"soon"},
+			expectErr: "Invalid reference timestamp",
+		},
+		{
+			name:      "too many options",
+			token:     testJWT(`{"exp":1705318200}`),
+			options:   []string{"2024-01-15T11:00:00Z", "UTC"},
+			expectErr: "Invalid options",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewJWTTimesFunction(), types.StringValue(tc.token), variadicStrings(tc.options...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				} else if strings.Contains(err.Error(), "secret-value") || strings.Contains(err.Error(), tc.token) {
+					t.Errorf("Error echoes the token: %q", err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var got map[string]string
+			if err := json.Unmarshal([]byte(result.(types.String).ValueString()), &got); err != nil {
+				t.Fatalf("Result is not a JSON object of strings: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ListTimezonesFunction{}
+
+type ListTimezonesFunction struct{}
+
+func NewListTimezonesFunction() function.Function {
+	return &ListTimezonesFunction{}
+}
+
+func (f *ListTimezonesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "list_timezones"
+}
+
+func (f *ListTimezonesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "List canonical IANA time zones",
+		Description: "Returns a JSON array of the canonical IANA time zones from the embedded zone1970.tab, sorted by name. " +
+			"Each element has name, country_codes (comma separated ISO 3166 codes) and comment. " +
+			"Optional arguments are a filter, where two letters select the zones used in that country and anything else selects zones whose name contains it, ignoring case, with an empty filter listing every zone; " +
+			"and a reference timestamp, usually plantimestamp(), which adds the offset, offset_seconds, abbreviation and is_dst in effect at that instant to each element. " +
+			"The reference may be " + timestampFormatSummary + "; dates without a time are midnight UTC. " +
+			"Link names such as 'US/Eastern' are not listed; use canonical_timezone to resolve them.",
+		VariadicParameter: function.StringParameter{
+			Name:        "options",
+			Description: "Optional ISO 3166 country code (e.g., 'US') or zone name substring (e.g., 'Europe/'), followed by an optional reference timestamp for offsets",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ListTimezonesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(options) > 2 {
+		resp.Error = function.NewFuncError("Invalid options: expected at most a filter and a reference timestamp")
+		return
+	}
+
+	var filter string
+	if len(options) > 0 {
+		filter = options[0]
+	}
+
+	var reference *time.Time
+	if len(options) == 2 {
+		t, err := parseAnyTimestamp(options[1])
+		if err != nil {
+			resp.Error = function.NewFuncError("Invalid reference timestamp: " + err.Error())
+			return
+		}
+		reference = &t
+	}
+
+	zones := []map[string]string{}
+	for _, zone := range canonicalZones() {
+		if !zoneMatchesFilter(zone, filter) {
+			continue
+		}
+
+		loc, err := loadLocation(zone.name)
+		if err != nil {
+			// The embedded zone1970.tab can be newer than the tzdata built
+			// into the provider; skip zones Go does not know yet.
+			continue
+		}
+
+		entry := map[string]string{
+			"name":          zone.name,
+			"country_codes": strings.Join(zone.countryCodes, ","),
+			"comment":       zone.comment,
+		}
+
+		if reference != nil {
+			local := reference.In(loc)
+			_, offset := local.Zone()
+
+			entry["offset"] = formatOffset(offset)
+			entry["offset_seconds"] = strconv.Itoa(offset)
+			entry["abbreviation"] = zoneAbbreviation(local)
+			entry["is_dst"] = strconv.FormatBool(local.IsDST())
+		}
+
+		zones = append(zones, entry)
+	}
+
+	result, err := json.Marshal(zones)
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to encode time zones: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(string(result)))
+}
+
+// zoneMatchesFilter reports whether a zone is used in the country named by a
+// two letter filter, or otherwise whether its name contains the filter.
+func zoneMatchesFilter(zone canonicalZone, filter string) bool {
+	if filter == "" {
+		return true
+	}
+
+	if len(filter) == 2 {
+		return slices.Contains(zone.countryCodes, strings.ToUpper(filter))
+	}
+
+	return strings.Contains(strings.ToLower(zone.name), strings.ToLower(filter))
+}
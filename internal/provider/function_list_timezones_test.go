@@ -0,0 +1,189 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestListTimezonesFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		filters   []string
+		contains  []string
+		excludes  []string
+		expectErr string
+	}{
+		{
+			name:     "no filter lists canonical zones only",
+			contains: []string{"America/New_York", "Asia/Kolkata", "Europe/London"},
+			excludes: []string{"US/Eastern", "Asia/Calcutta", "Local"},
+		},
+		{
+			name:     "country code",
+			filters:  []string{"ch"},
+			contains: []string{"Europe/Zurich"},
+			excludes: []string{"Europe/Berlin"},
+		},
+		{
+			name:     "zone shared between countries",
+			filters:  []string{"DK"},
+			contains: []string{"Europe/Berlin"},
+			excludes: []string{"Europe/Zurich"},
+		},
+		{
+			name:     "name substring ignores case",
+			filters:  []string{"australia/"},
+			contains: []string{"Australia/Sydney", "Australia/Perth"},
+			excludes: []string{"Pacific/Auckland"},
+		},
+		{
+			name:    "no matches",
+			filters: []string{"Atlantis/"},
+		},
+		{
+			name:     "empty filter with reference",
+			filters:  []string{"", "2024-01-15T12:00:00Z"},
+			contains: []string{"America/New_York", "Europe/London"},
+		},
+		{
+			name:      "invalid reference",
+			filters:   []string{"US", "now"},
+			expectErr: "Invalid reference timestamp",
+		},
+		{
+			name:      "too many options",
+			filters:   []string{"US", "2024-01-15T12:00:00Z", "CA"},
+			expectErr: "Invalid options",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewListTimezonesFunction(), variadicStrings(tc.filters...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for filters %q, but got none", tc.filters)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for filters %q: %v", tc.filters, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			var zones []map[string]string
+			if err := json.Unmarshal([]byte(got.ValueString()), &zones); err != nil {
+				t.Fatalf("Result is not a JSON array of objects: %v", err)
+			}
+
+			names := map[string]map[string]string{}
+			for _, zone := range zones {
+				names[zone["name"]] = zone
+			}
+
+			for _, name := range tc.contains {
+				if _, ok := names[name]; !ok {
+					t.Errorf("Expected %q in result", name)
+				}
+			}
+
+			for _, name := range tc.excludes {
+				if _, ok := names[name]; ok {
+					t.Errorf("Did not expect %q in result", name)
+				}
+			}
+		})
+	}
+}
+
+func TestListTimezonesFunctionFields(t *testing.T) {
+	result, err := runFunction(t, NewListTimezonesFunction(), variadicStrings("Asia/Kolkata", "2024-07-15T12:00:00Z"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got, ok := result.(types.String)
+	if !ok {
+		t.Fatalf("Expected types.String, got %T", result)
+	}
+
+	var zones []map[string]string
+	if err := json.Unmarshal([]byte(got.ValueString()), &zones); err != nil {
+		t.Fatalf("Result is not a JSON array of objects: %v", err)
+	}
+
+	if len(zones) != 1 {
+		t.Fatalf("Expected 1 zone, got %d", len(zones))
+	}
+
+	expected := map[string]string{
+		"name":           "Asia/Kolkata",
+		"country_codes":  "IN",
+		"comment":        "",
+		"offset":         "+05:30",
+		"offset_seconds": "19800",
+		"abbreviation":   "IST",
+		"is_dst":         "false",
+	}
+
+	for key, want := range expected {
+		if zones[0][key] != want {
+			t.Errorf("Expected %s %q, got %q", key, want, zones[0][key])
+		}
+	}
+}
+
+func TestListTimezonesFunctionReference(t *testing.T) {
+	// The clock must not affect the result, so that it is the same at plan
+	// and apply.
+	t.Setenv(fixedNowEnvVar, "2024-07-15T12:00:00Z")
+
+	for _, tc := range []struct {
+		options  []string
+		expected map[string]string
+	}{
+		{
+			options:  []string{"Europe/Berlin"},
+			expected: map[string]string{"name": "Europe/Berlin", "country_codes": "DE,DK,NO,SE,SJ", "comment": "most of Germany"},
+		},
+		{
+			options:  []string{"Europe/Berlin", "2024-01-15"},
+			expected: map[string]string{"name": "Europe/Berlin", "country_codes": "DE,DK,NO,SE,SJ", "comment": "most of Germany", "offset": "+01:00", "offset_seconds": "3600", "abbreviation": "CET", "is_dst": "false"},
+		},
+		{
+			options:  []string{"Europe/Berlin", "1721044800"},
+			expected: map[string]string{"name": "Europe/Berlin", "country_codes": "DE,DK,NO,SE,SJ", "comment": "most of Germany", "offset": "+02:00", "offset_seconds": "7200", "abbreviation": "CEST", "is_dst": "true"},
+		},
+	} {
+		result, err := runFunction(t, NewListTimezonesFunction(), variadicStrings(tc.options...))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var zones []map[string]string
+		if err := json.Unmarshal([]byte(result.(types.String).ValueString()), &zones); err != nil {
+			t.Fatalf("Result is not a JSON array of objects: %v", err)
+		}
+
+		if len(zones) != 1 || !reflect.DeepEqual(zones[0], tc.expected) {
+			t.Errorf("Options %q: expected %v, got %v", tc.options, tc.expected, zones)
+		}
+	}
+}
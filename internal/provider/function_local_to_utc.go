@@ -0,0 +1,77 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &LocalToUTCFunction{}
+
+type LocalToUTCFunction struct{}
+
+func NewLocalToUTCFunction() function.Function {
+	return &LocalToUTCFunction{}
+}
+
+func (f *LocalToUTCFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "local_to_utc"
+}
+
+func (f *LocalToUTCFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a local date and time in a time zone to UTC",
+		Description: "Converts a wall clock date and time without an offset (e.g., '2024-03-10T02:30:00') in an IANA time zone into an RFC3339 UTC timestamp. " +
+			"The disambiguation policy controls local times that occur twice when clocks fall back or not at all when they spring forward: " +
+			"'earlier' picks the earlier instant, 'later' picks the later instant, 'compatible' picks the earlier instant for repeated times and the later instant for skipped times, " +
+			"and 'reject' returns an error.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "local_datetime",
+				Description: "Local date and time without an offset (e.g., '2024-03-10T02:30:00')",
+			},
+			function.StringParameter{
+				Name:        "zone",
+				Description: "IANA time zone name (e.g., 'America/New_York')",
+			},
+			function.StringParameter{
+				Name:        "disambiguation",
+				Description: "One of 'compatible', 'earlier', 'later' or 'reject'",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *LocalToUTCFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var localDateTime, zone, disambiguation string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &localDateTime, &zone, &disambiguation))
+	if resp.Error != nil {
+		return
+	}
+
+	wall, err := parseLocalDateTime(localDateTime)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid local date and time: " + err.Error())
+		return
+	}
+
+	loc, err := loadLocation(zone)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+		return
+	}
+
+	t, err := resolveLocalTime(wall, loc, disambiguation)
+	if err != nil {
+		resp.Error = function.NewFuncError("Cannot resolve local time: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(t.UTC())))
+}
@@ -0,0 +1,159 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLocalToUTCFunction(t *testing.T) {
+	testCases := []struct {
+		name           string
+		local          string
+		zone           string
+		disambiguation string
+		expected       string
+		expectErr      string
+	}{
+		{
+			name:           "unambiguous time",
+			local:          "2024-01-15T09:00:00",
+			zone:           "America/New_York",
+			disambiguation: "reject",
+			expected:       "2024-01-15T14:00:00Z",
+		},
+		{
+			name:           "minutes only",
+			local:          "2024-07-15 09:00",
+			zone:           "Europe/Berlin",
+			disambiguation: "compatible",
+			expected:       "2024-07-15T07:00:00Z",
+		},
+		{
+			name:           "skipped time compatible",
+			local:          "2024-03-10T02:30:00",
+			zone:           "America/New_York",
+			disambiguation: "compatible",
+			expected:       "2024-03-10T07:30:00Z",
+		},
+		{
+			name:           "skipped time earlier",
+			local:          "2024-03-10T02:30:00",
+			zone:           "America/New_York",
+			disambiguation: "earlier",
+			expected:       "2024-03-10T06:30:00Z",
+		},
+		{
+			name:           "skipped time later",
+			local:          "2024-03-10T02:30:00",
+			zone:           "America/New_York",
+			disambiguation: "later",
+			expected:       "2024-03-10T07:30:00Z",
+		},
+		{
+			name:           "skipped time rejected",
+			local:          "2024-03-10T02:30:00",
+			zone:           "America/New_York",
+			disambiguation: "reject",
+			expectErr:      "does not allow skipped local times",
+		},
+		{
+			name:           "repeated time compatible",
+			local:          "2024-11-03T01:30:00",
+			zone:           "America/New_York",
+			disambiguation: "compatible",
+			expected:       "2024-11-03T05:30:00Z",
+		},
+		{
+			name:           "repeated time earlier",
+			local:          "2024-11-03T01:30:00",
+			zone:           "America/New_York",
+			disambiguation: "earlier",
+			expected:       "2024-11-03T05:30:00Z",
+		},
+		{
+			name:           "repeated time later",
+			local:          "2024-11-03T01:30:00",
+			zone:           "America/New_York",
+			disambiguation: "later",
+			expected:       "2024-11-03T06:30:00Z",
+		},
+		{
+			name:           "repeated time rejected",
+			local:          "2024-11-03T01:30:00",
+			zone:           "America/New_York",
+			disambiguation: "reject",
+			expectErr:      "does not allow ambiguous local times",
+		},
+		{
+			name:           "southern hemisphere skipped time",
+			local:          "2024-10-06T02:15:00",
+			zone:           "Australia/Sydney",
+			disambiguation: "compatible",
+			expected:       "2024-10-05T16:15:00Z",
+		},
+		{
+			name:           "fractional seconds",
+			local:          "2024-01-15T09:00:00.5",
+			zone:           "UTC",
+			disambiguation: "reject",
+			expected:       "2024-01-15T09:00:00.5Z",
+		},
+		{
+			name:           "offset not allowed",
+			local:          "2024-01-15T09:00:00Z",
+			zone:           "UTC",
+			disambiguation: "reject",
+			expectErr:      "without an offset",
+		},
+		{
+			name:           "unknown zone",
+			local:          "2024-01-15T09:00:00",
+			zone:           "Nowhere/Special",
+			disambiguation: "reject",
+			expectErr:      "unknown time zone",
+		},
+		{
+			name:           "unknown policy",
+			local:          "2024-01-15T09:00:00",
+			zone:           "UTC",
+			disambiguation: "nearest",
+			expectErr:      "unknown disambiguation",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewLocalToUTCFunction(),
+				types.StringValue(tc.local), types.StringValue(tc.zone), types.StringValue(tc.disambiguation))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.local)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.local, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
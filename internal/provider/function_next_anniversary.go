@@ -0,0 +1,117 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &NextAnniversaryFunction{}
+
+type NextAnniversaryFunction struct{}
+
+func NewNextAnniversaryFunction() function.Function {
+	return &NextAnniversaryFunction{}
+}
+
+func (f *NextAnniversaryFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "next_anniversary"
+}
+
+func (f *NextAnniversaryFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Get the next anniversary of a timestamp",
+		Description: "Returns the first anniversary of date strictly after reference as an RFC3339 timestamp, keeping date's time of day on the local wall clock. " +
+			"Optional arguments are the interval in years between anniversaries (default 1, e.g., 3 for a three-year renewal), " +
+			"an IANA time zone whose wall clock the anniversary is observed on, defaulting to date's own offset when omitted or empty, " +
+			"and the leap day handling for a 29 February date in common years: 'feb28' (default) or 'mar1'. " +
+			"Timestamps may be " + timestampFormatSummary + "; dates without a time are midnight UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "date",
+				Description: "Timestamp whose anniversaries are counted (e.g., '2021-06-30')",
+			},
+			function.StringParameter{
+				Name:        "reference",
+				Description: "Timestamp the next anniversary must be after",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "options",
+			Description: "Optional interval in years, followed by an optional IANA time zone name and leap day handling (e.g., '3', 'Europe/London', 'mar1')",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NextAnniversaryFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var dateTimestamp, referenceTimestamp string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &dateTimestamp, &referenceTimestamp, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(options) > 3 {
+		resp.Error = function.NewFuncError("Invalid options: expected at most an interval in years, a time zone and a leap day handling")
+		return
+	}
+
+	date, err := parseAnyTimestamp(dateTimestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid date: " + err.Error())
+		return
+	}
+
+	reference, err := parseAnyTimestamp(referenceTimestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid reference timestamp: " + err.Error())
+		return
+	}
+
+	intervalYears := 1
+	if len(options) > 0 && options[0] != "" {
+		intervalYears, err = strconv.Atoi(options[0])
+		if err != nil || intervalYears < 1 || intervalYears > 9999 {
+			resp.Error = function.NewFuncError("Invalid interval_years: " + strconv.Quote(options[0]) + " is not a whole number of years from 1 to 9999")
+			return
+		}
+	}
+
+	loc, err := optionalLocation(options[min(len(options), 1):min(len(options), 2)], date)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+		return
+	}
+
+	policy := ""
+	if len(options) == 3 {
+		policy = options[2]
+	}
+
+	policy, err = parseLeapDayPolicy(policy)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid leap day handling: " + err.Error())
+		return
+	}
+
+	next, err := nextAnniversary(wallClock(date, loc), wallClock(reference, loc), intervalYears, policy)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid date: " + err.Error())
+		return
+	}
+
+	instant, err := resolveLocalTime(next, loc, disambiguationCompatible)
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to resolve anniversary: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(instant.In(loc))))
+}
@@ -0,0 +1,138 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNextAnniversaryFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		date      string
+		reference string
+		options   []string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:      "later this year",
+			date:      "2021-06-30",
+			reference: "2024-01-10T00:00:00Z",
+			expected:  "2024-06-30T00:00:00Z",
+		},
+		{
+			name:      "on the anniversary moves to next year",
+			date:      "2021-06-30",
+			reference: "2024-06-30",
+			expected:  "2025-06-30T00:00:00Z",
+		},
+		{
+			name:      "three year interval",
+			date:      "2021-06-30",
+			reference: "2024-07-01",
+			options:   []string{"3"},
+			expected:  "2027-06-30T00:00:00Z",
+		},
+		{
+			name:      "reference before date",
+			date:      "2021-06-30",
+			reference: "2020-01-01",
+			options:   []string{"3"},
+			expected:  "2024-06-30T00:00:00Z",
+		},
+		{
+			name:      "keeps time of day and offset",
+			date:      "2021-06-30T09:15:00+05:30",
+			reference: "2024-06-30T03:00:00Z",
+			expected:  "2024-06-30T09:15:00+05:30",
+		},
+		{
+			name:      "leap day on 28 February",
+			date:      "2020-02-29",
+			reference: "2021-01-01",
+			expected:  "2021-02-28T00:00:00Z",
+		},
+		{
+			name:      "leap day on 1 March",
+			date:      "2020-02-29",
+			reference: "2021-01-01",
+			options:   []string{"", "", "mar1"},
+			expected:  "2021-03-01T00:00:00Z",
+		},
+		{
+			name:      "leap day in a leap year",
+			date:      "2020-02-29",
+			reference: "2023-06-01",
+			expected:  "2024-02-29T00:00:00Z",
+		},
+		{
+			name:      "time zone wall clock",
+			date:      "2023-12-31T23:00:00-05:00",
+			reference: "2024-06-01",
+			options:   []string{"", "America/New_York"},
+			expected:  "2024-12-31T23:00:00-05:00",
+		},
+		{
+			name:      "anniversary observed in UTC",
+			date:      "2023-12-31T23:00:00-05:00",
+			reference: "2024-06-01",
+			options:   []string{"1", "UTC"},
+			expected:  "2025-01-01T04:00:00Z",
+		},
+		{
+			name:      "zero interval",
+			date:      "2021-06-30",
+			reference: "2024-01-01",
+			options:   []string{"0"},
+			expectErr: "Invalid interval_years",
+		},
+		{
+			name:      "unknown time zone",
+			date:      "2021-06-30",
+			reference: "2024-01-01",
+			options:   []string{"1", "Mars/Olympus_Mons"},
+			expectErr: "Invalid time zone",
+		},
+		{
+			name:      "past year 9999",
+			date:      "2021-06-30",
+			reference: "9999-07-01",
+			expectErr: "after year 9999",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewNextAnniversaryFunction(), types.StringValue(tc.date), types.StringValue(tc.reference), variadicStrings(tc.options...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for date %q, but got none", tc.date)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for date %q: %v", tc.date, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
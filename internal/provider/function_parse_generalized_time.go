@@ -0,0 +1,76 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseGeneralizedTimeFunction{}
+
+type ParseGeneralizedTimeFunction struct{}
+
+func NewParseGeneralizedTimeFunction() function.Function {
+	return &ParseGeneralizedTimeFunction{}
+}
+
+func (f *ParseGeneralizedTimeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_generalized_time"
+}
+
+func (f *ParseGeneralizedTimeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an ASN.1 GeneralizedTime into RFC3339",
+		Description: "Parses an ASN.1 GeneralizedTime as used by LDAP attributes such as whenCreated, X.509 and SNMP (e.g., '20240115103000.0Z') and returns it as an RFC3339 timestamp in the value's own offset. " +
+			"Minutes and seconds may be omitted, and a fraction after a period or comma applies to the last unit present, so '2024011510.5Z' is 10:30. " +
+			"The value may end in Z, a +HH or +HHMM offset, or nothing for a local time, which is read on the wall clock of the optional IANA time zone argument, defaulting to UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "GeneralizedTime value (e.g., '20240115103000.0Z')",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "options",
+			Description: "Optional IANA time zone for values without a Z or offset",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ParseGeneralizedTimeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(options) > 1 {
+		resp.Error = function.NewFuncError("Invalid options: expected at most a time zone")
+		return
+	}
+
+	loc := time.UTC
+	if len(options) == 1 && options[0] != "" {
+		var err error
+		if loc, err = loadLocation(options[0]); err != nil {
+			resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+			return
+		}
+	}
+
+	t, err := parseGeneralizedTime(value, loc)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid GeneralizedTime: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(t)))
+}
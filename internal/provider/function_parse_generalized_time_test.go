@@ -0,0 +1,140 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseGeneralizedTimeFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		value     string
+		options   []string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:     "UTC with seconds",
+			value:    "20240115103000Z",
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:     "LDAP whenCreated with a zero fraction",
+			value:    "20240115103000.0Z",
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:     "fractional seconds",
+			value:    "20240115103000.123456Z",
+			expected: "2024-01-15T10:30:00.123456Z",
+		},
+		{
+			name:     "comma as decimal separator",
+			value:    "20240115103000,5Z",
+			expected: "2024-01-15T10:30:00.5Z",
+		},
+		{
+			name:     "fraction of an hour",
+			value:    "2024011510.5Z",
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:     "fraction of a minute",
+			value:    "202401151030.25Z",
+			expected: "2024-01-15T10:30:15Z",
+		},
+		{
+			name:     "offset with minutes",
+			value:    "20240115103000+0530",
+			expected: "2024-01-15T10:30:00+05:30",
+		},
+		{
+			name:     "offset in hours",
+			value:    "20240115103000-08",
+			expected: "2024-01-15T10:30:00-08:00",
+		},
+		{
+			name:     "local time defaults to UTC",
+			value:    "20240115103000",
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:     "local time in a zone",
+			value:    "20240715103000",
+			options:  []string{"Europe/Berlin"},
+			expected: "2024-07-15T10:30:00+02:00",
+		},
+		{
+			name:     "local time in a DST gap",
+			value:    "20240310023000",
+			options:  []string{"America/New_York"},
+			expected: "2024-03-10T03:30:00-04:00",
+		},
+		{
+			name:     "time zone ignored with an explicit suffix",
+			value:    "20240115103000Z",
+			options:  []string{"Asia/Tokyo"},
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:      "RFC3339 rejected",
+			value:     "2024-01-15T10:30:00Z",
+			expectErr: "Invalid GeneralizedTime",
+		},
+		{
+			name:      "out of range day",
+			value:     "20240230103000Z",
+			expectErr: "out of range",
+		},
+		{
+			name:      "out of range offset",
+			value:     "20240115103000+2400",
+			expectErr: "UTC offset +2400 is out of range",
+		},
+		{
+			name:      "fraction too long",
+			value:     "20240115103000.1234567890Z",
+			expectErr: "at most 9 digits",
+		},
+		{
+			name:      "invalid time zone",
+			value:     "20240115103000",
+			options:   []string{"Mars/Olympus"},
+			expectErr: "Invalid time zone",
+		},
+		{
+			name:      "too many options",
+			value:     "20240115103000Z",
+			options:   []string{"UTC", "UTC"},
+			expectErr: "Invalid options",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewParseGeneralizedTimeFunction(), types.StringValue(tc.value), variadicStrings(tc.options...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := result.(types.String).ValueString(); got != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseNaturalFunction{}
+
+type ParseNaturalFunction struct{}
+
+func NewParseNaturalFunction() function.Function {
+	return &ParseNaturalFunction{}
+}
+
+func (f *ParseNaturalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_natural"
+}
+
+func (f *ParseNaturalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Resolve an English relative time expression against a reference",
+		Description: "Resolves an English expression such as 'next tuesday 09:00', 'in 2 weeks' or 'end of month' against reference, never the current time, and returns an RFC3339 timestamp. " +
+			"Expressions are case-insensitive and take one of these forms: " +
+			"'now'; " +
+			"'in AMOUNT', 'AMOUNT from now' or 'AMOUNT ago', where AMOUNT is one or more counts and units separated by 'and' or commas (e.g., 'in 1 week and 2 days', 'an hour ago') with units second, minute, hour, day, week, month, quarter or year, singular or plural, and 'a' or 'an' counting one; " +
+			"'DAY [at] [TIME]', where DAY is 'today', 'tomorrow', 'yesterday', 'next WEEKDAY' (the first such day after today, one to seven days ahead) or 'last WEEKDAY' (the last such day before today) and TIME is 24-hour ('09:00', '17:30:15'), 12-hour ('9am', '9:30pm'), 'noon' or 'midnight', defaulting to midnight; " +
+			"or 'start of', 'beginning of' or 'end of' followed by an optional 'the', 'this', 'next' or 'last' and one of day, week, month, quarter or year, where the end is the last whole second. " +
+			"Ambiguous input is an error rather than a guess: a weekday or time of day alone, 'this WEEKDAY', and 'next month' or similar, which could mean one month from now or the start of next month. " +
+			"Hours, minutes and seconds are elapsed time, while other units, days and boundaries are on the wall clock of the optional IANA time zone argument, defaulting to reference's own offset. Weeks start on Monday. A wall time repeated by a daylight saving transition resolves to the earlier instant, and one skipped by a transition moves forward past the gap. " +
+			"The reference may be " + timestampFormatSummary + "; dates without a time are midnight UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "expression",
+				Description: "Relative time expression (e.g., 'next tuesday 09:00', 'in 2 weeks', 'end of month')",
+			},
+			function.StringParameter{
+				Name:        "reference",
+				Description: "Timestamp the expression is relative to, usually timestamp() or a fixed value",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "options",
+			Description: "Optional IANA time zone to resolve days and times of day in (e.g., 'Europe/London')",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ParseNaturalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, referenceTimestamp string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &referenceTimestamp, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(options) > 1 {
+		resp.Error = function.NewFuncError("Invalid options: expected at most a time zone")
+		return
+	}
+
+	reference, err := parseAnyTimestamp(referenceTimestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid reference timestamp: " + err.Error())
+		return
+	}
+
+	loc, err := optionalLocation(options, reference)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+		return
+	}
+
+	t, err := parseNatural(expression, reference, loc)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid expression: " + err.Error())
+		return
+	}
+
+	if t.Year() < 0 || t.Year() > 9999 {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Invalid expression: %q resolves to year %d, outside the RFC3339 range 0 to 9999", expression, t.Year()))
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(t)))
+}
@@ -0,0 +1,284 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseNaturalFunction(t *testing.T) {
+	// A Wednesday.
+	const reference = "2024-01-17T10:30:00Z"
+
+	testCases := []struct {
+		name       string
+		expression string
+		reference  string
+		options    []string
+		expected   string
+		expectErr  string
+	}{
+		{
+			name:       "now",
+			expression: "now",
+			expected:   "2024-01-17T10:30:00Z",
+		},
+		{
+			name:       "next weekday with time",
+			expression: "next tuesday 09:00",
+			expected:   "2024-01-23T09:00:00Z",
+		},
+		{
+			name:       "next weekday is tomorrow",
+			expression: "next thursday at 9:30pm",
+			expected:   "2024-01-18T21:30:00Z",
+		},
+		{
+			name:       "next weekday is the same weekday",
+			expression: "next wednesday",
+			expected:   "2024-01-24T00:00:00Z",
+		},
+		{
+			name:       "last weekday",
+			expression: "last wednesday",
+			expected:   "2024-01-10T00:00:00Z",
+		},
+		{
+			name:       "last weekday at noon",
+			expression: "last mon noon",
+			expected:   "2024-01-15T12:00:00Z",
+		},
+		{
+			name:       "case and spacing ignored",
+			expression: "  Next  Tuesday at 9 AM ",
+			expected:   "2024-01-23T09:00:00Z",
+		},
+		{
+			name:       "today at midnight",
+			expression: "today at midnight",
+			expected:   "2024-01-17T00:00:00Z",
+		},
+		{
+			name:       "tomorrow",
+			expression: "tomorrow 17:45:30",
+			expected:   "2024-01-18T17:45:30Z",
+		},
+		{
+			name:       "yesterday at 12am",
+			expression: "yesterday 12am",
+			expected:   "2024-01-16T00:00:00Z",
+		},
+		{
+			name:       "in weeks",
+			expression: "in 2 weeks",
+			expected:   "2024-01-31T10:30:00Z",
+		},
+		{
+			name:       "in a month clamped to the end of February",
+			expression: "in a month",
+			reference:  "2024-01-31T10:00:00Z",
+			expected:   "2024-02-29T10:00:00Z",
+		},
+		{
+			name:       "in an hour and minutes",
+			expression: "in an hour and 30 minutes",
+			expected:   "2024-01-17T12:00:00Z",
+		},
+		{
+			name:       "ago",
+			expression: "3 days ago",
+			expected:   "2024-01-14T10:30:00Z",
+		},
+		{
+			name:       "from now with commas",
+			expression: "2 weeks, 1 day from now",
+			expected:   "2024-02-01T10:30:00Z",
+		},
+		{
+			name:       "end of month",
+			expression: "end of month",
+			expected:   "2024-01-31T23:59:59Z",
+		},
+		{
+			name:       "start of next month",
+			expression: "start of next month",
+			expected:   "2024-02-01T00:00:00Z",
+		},
+		{
+			name:       "end of the quarter",
+			expression: "end of the quarter",
+			expected:   "2024-03-31T23:59:59Z",
+		},
+		{
+			name:       "beginning of last week",
+			expression: "beginning of last week",
+			expected:   "2024-01-08T00:00:00Z",
+		},
+		{
+			name:       "end of next year",
+			expression: "end of next year",
+			expected:   "2025-12-31T23:59:59Z",
+		},
+		{
+			name:       "reference offset by default",
+			expression: "today 17:00",
+			reference:  "2024-01-17T10:30:00-05:00",
+			expected:   "2024-01-17T17:00:00-05:00",
+		},
+		{
+			name:       "days in a time zone",
+			expression: "tomorrow 09:00",
+			reference:  "2024-01-17T23:30:00Z",
+			options:    []string{"Asia/Tokyo"},
+			expected:   "2024-01-19T09:00:00+09:00",
+		},
+		{
+			name:       "day across a DST transition",
+			expression: "in 1 day",
+			reference:  "2024-03-09T12:00:00-05:00",
+			options:    []string{"America/New_York"},
+			expected:   "2024-03-10T12:00:00-04:00",
+		},
+		{
+			name:       "hours across a DST transition",
+			expression: "in 24 hours",
+			reference:  "2024-03-09T12:00:00-05:00",
+			options:    []string{"America/New_York"},
+			expected:   "2024-03-10T13:00:00-04:00",
+		},
+		{
+			name:       "time skipped by a DST transition",
+			expression: "tomorrow 02:30",
+			reference:  "2024-03-09T12:00:00-05:00",
+			options:    []string{"America/New_York"},
+			expected:   "2024-03-10T03:30:00-04:00",
+		},
+		{
+			name:       "date reference",
+			expression: "end of day",
+			reference:  "2024-01-17",
+			expected:   "2024-01-17T23:59:59Z",
+		},
+		{
+			name:       "weekday alone",
+			expression: "tuesday",
+			expectErr:  "a weekday alone is ambiguous, say \"next tuesday\" or \"last tuesday\"",
+		},
+		{
+			name:       "time of day alone",
+			expression: "at 09:00",
+			expectErr:  "a time of day alone is ambiguous",
+		},
+		{
+			name:       "this weekday",
+			expression: "this friday",
+			expectErr:  "\"this friday\" is ambiguous",
+		},
+		{
+			name:       "next unit",
+			expression: "next month",
+			expectErr:  "\"next month\" is ambiguous, say \"in 1 month\", \"1 month ago\", \"start of next month\" or \"end of next month\"",
+		},
+		{
+			name:       "unsupported expression",
+			expression: "soonish",
+			expectErr:  "not a supported expression",
+		},
+		{
+			name:       "unknown unit",
+			expression: "in 2 fortnights",
+			expectErr:  "unknown unit \"fortnights\"",
+		},
+		{
+			name:       "count in words",
+			expression: "in two weeks",
+			expectErr:  "\"two\" is not a whole number",
+		},
+		{
+			name:       "missing unit",
+			expression: "in 2",
+			expectErr:  "expected counts followed by units",
+		},
+		{
+			name:       "hour out of range",
+			expression: "tomorrow 25:00",
+			expectErr:  "hour outside 0 to 23",
+		},
+		{
+			name:       "12-hour time out of range",
+			expression: "tomorrow 13pm",
+			expectErr:  "hour outside 1 to 12",
+		},
+		{
+			name:       "trailing words",
+			expression: "tomorrow morning",
+			expectErr:  "is not a time of day",
+		},
+		{
+			name:       "unknown boundary unit",
+			expression: "end of fortnight",
+			expectErr:  "expected one of day, week, month, quarter, year after \"end of\"",
+		},
+		{
+			name:       "empty expression",
+			expression: " ",
+			expectErr:  "expression must not be empty",
+		},
+		{
+			name:       "result out of range",
+			expression: "in 9000 years",
+			expectErr:  "resolves to year 11024",
+		},
+		{
+			name:       "invalid reference",
+			expression: "now",
+			reference:  "today",
+			expectErr:  "Invalid reference timestamp",
+		},
+		{
+			name:       "invalid time zone",
+			expression: "now",
+			options:    []string{"Mars/Olympus"},
+			expectErr:  "Invalid time zone",
+		},
+		{
+			name:       "too many options",
+			expression: "now",
+			options:    []string{"UTC", "UTC"},
+			expectErr:  "Invalid options",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ref := tc.reference
+			if ref == "" {
+				ref = reference
+			}
+
+			result, err := runFunction(t, NewParseNaturalFunction(),
+				types.StringValue(tc.expression), types.StringValue(ref), variadicStrings(tc.options...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := result.(types.String).ValueString(); got != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
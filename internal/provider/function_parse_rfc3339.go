@@ -27,7 +27,7 @@ func (f *ParseRFC3339Function) Metadata(ctx context.Context, req function.Metada
 func (f *ParseRFC3339Function) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Parse RFC3339 timestamp into components",
-		Description: "Parses an RFC3339 timestamp and returns a JSON string with year, month, day, hour, minute, second, and unix timestamp",
+		Description: "Parses an RFC3339 timestamp and returns a JSON string with year, month, day, hour, minute, second, unix timestamp, weekday, day of year, and Julian Day",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "timestamp",
@@ -59,7 +59,9 @@ func (f *ParseRFC3339Function) Run(ctx context.Context, req function.RunRequest,
 		`","minute":"` + strconv.Itoa(t.Minute()) +
 		`","second":"` + strconv.Itoa(t.Second()) +
 		`","unix":"` + strconv.FormatInt(t.Unix(), 10) +
-		`","weekday":"` + strconv.Itoa(int(t.Weekday())) + `"}`
+		`","weekday":"` + strconv.Itoa(int(t.Weekday())) +
+		`","day_of_year":"` + strconv.Itoa(t.YearDay()) +
+		`","julian_day":"` + formatFractionalDays(daysSinceEpoch(t, julianDayUnixEpoch)) + `"}`
 
 	resp.Result = function.NewResultData(types.StringValue(result))
 }
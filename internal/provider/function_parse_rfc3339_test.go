@@ -24,28 +24,32 @@ func TestParseRFC3339Function(t *testing.T) {
 			name:  "valid RFC3339 timestamp",
 			input: "2024-01-15T10:30:45Z",
 			expected: map[string]string{
-				"year":    "2024",
-				"month":   "1",
-				"day":     "15",
-				"hour":    "10",
-				"minute":  "30",
-				"second":  "45",
-				"unix":    "1705314645",
-				"weekday": "1", // Monday
+				"year":        "2024",
+				"month":       "1",
+				"day":         "15",
+				"hour":        "10",
+				"minute":      "30",
+				"second":      "45",
+				"unix":        "1705314645",
+				"weekday":     "1", // Monday
+				"day_of_year": "15",
+				"julian_day":  "2460324.938020833",
 			},
 		},
 		{
 			name:  "different date",
 			input: "2023-12-25T23:59:59Z",
 			expected: map[string]string{
-				"year":    "2023",
-				"month":   "12",
-				"day":     "25",
-				"hour":    "23",
-				"minute":  "59",
-				"second":  "59",
-				"unix":    "1703548799",
-				"weekday": "1", // Monday
+				"year":        "2023",
+				"month":       "12",
+				"day":         "25",
+				"hour":        "23",
+				"minute":      "59",
+				"second":      "59",
+				"unix":        "1703548799",
+				"weekday":     "1", // Monday
+				"day_of_year": "359",
+				"julian_day":  "2460304.499988426",
 			},
 		},
 		{
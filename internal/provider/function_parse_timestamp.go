@@ -0,0 +1,113 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseTimestampFunction{}
+
+type ParseTimestampFunction struct{}
+
+func NewParseTimestampFunction() function.Function {
+	return &ParseTimestampFunction{}
+}
+
+func (f *ParseTimestampFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_timestamp"
+}
+
+func (f *ParseTimestampFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a timestamp in a named format into RFC3339",
+		Description: "Parses value in the named format and returns it as an RFC3339 timestamp in the value's own offset, or in the time zone for formats without one. " +
+			"Formats are 'rfc3339', 'date', 'iso_week_date', 'ordinal_date' and 'unix', as accepted wherever the provider takes a timestamp, and " +
+			"'http_date' for RFC 7231 HTTP-dates such as Last-Modified headers in IMF-fixdate ('Mon, 15 Jan 2024 10:30:00 GMT'), RFC 850 or asctime form; " +
+			"'syslog_rfc3164' for BSD syslog timestamps ('Jan 15 10:30:00'); 'syslog_rfc5424' for RFC 5424 syslog timestamps ('2024-01-15T10:30:00.123Z'); " +
+			"'clf' for Apache and Nginx Common Log Format timestamps ('15/Jan/2024:10:30:00 +0000'); and 'generalized_time' and 'utctime' for ASN.1 times. " +
+			"Syslog values may also be whole messages starting with the <PRI> header. " +
+			"Optional arguments are an IANA time zone for syslog_rfc3164 and generalized_time values without an offset, defaulting to UTC when omitted or empty; " +
+			"a reference timestamp, required by syslog_rfc3164 to infer the year and used by http_date to resolve RFC 850 two-digit years; " +
+			"and the syslog_rfc3164 year inference: 'nearest' (default) for the year placing the timestamp closest to reference, 'past' for the latest year placing it at or before reference, or 'current' for reference's year in the time zone. " +
+			"The reference may be " + timestampFormatSummary + ".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "Timestamp to parse (e.g., 'Mon, 15 Jan 2024 10:30:00 GMT')",
+			},
+			function.StringParameter{
+				Name:        "format",
+				Description: "Name of the format value is in (e.g., 'http_date', 'syslog_rfc3164', 'clf')",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "options",
+			Description: "Optional IANA time zone name, followed by an optional reference timestamp and year inference (e.g., 'Europe/London', '2024-01-20T00:00:00Z', 'past')",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ParseTimestampFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value, format string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value, &format, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(options) > 3 {
+		resp.Error = function.NewFuncError("Invalid options: expected at most a time zone, a reference timestamp and a year inference")
+		return
+	}
+
+	parse, err := namedTimestampParser(format)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid format: " + err.Error())
+		return
+	}
+
+	parseOptions := timestampParseOptions{loc: time.UTC, yearInference: yearInferenceNearest}
+
+	if len(options) > 0 && options[0] != "" {
+		if parseOptions.loc, err = loadLocation(options[0]); err != nil {
+			resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+			return
+		}
+	}
+
+	if len(options) > 1 && options[1] != "" {
+		reference, err := parseAnyTimestamp(options[1])
+		if err != nil {
+			resp.Error = function.NewFuncError("Invalid reference timestamp: " + err.Error())
+			return
+		}
+		parseOptions.reference = &reference
+	}
+
+	if len(options) > 2 && options[2] != "" {
+		if !slices.Contains(yearInferencePolicies, options[2]) {
+			resp.Error = function.NewFuncError("Invalid year inference: " + strconv.Quote(options[2]) + " is not one of " + strings.Join(yearInferencePolicies, ", "))
+			return
+		}
+		parseOptions.yearInference = options[2]
+	}
+
+	t, err := parse(value, parseOptions)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamp: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(t)))
+}
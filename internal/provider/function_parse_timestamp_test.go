@@ -0,0 +1,300 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseTimestampFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		value     string
+		format    string
+		options   []string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:     "IMF-fixdate",
+			value:    "Mon, 15 Jan 2024 10:30:00 GMT",
+			format:   "http_date",
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:     "RFC 850 date",
+			value:    "Sunday, 06-Nov-94 08:49:37 GMT",
+			format:   "http_date",
+			expected: "1994-11-06T08:49:37Z",
+		},
+		{
+			name:     "RFC 850 date in this century",
+			value:    "Monday, 15-Jan-24 10:30:00 GMT",
+			format:   "http_date",
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:     "RFC 850 year more than 50 years after reference",
+			value:    "Sunday, 06-Nov-94 08:49:37 GMT",
+			format:   "http_date",
+			options:  []string{"", "2040-01-01T00:00:00Z"},
+			expected: "1994-11-06T08:49:37Z",
+		},
+		{
+			name:     "RFC 850 year in the previous century of reference",
+			value:    "Tuesday, 15-Jan-24 10:30:00 GMT",
+			format:   "http_date",
+			options:  []string{"", "1960-01-01"},
+			expected: "1924-01-15T10:30:00Z",
+		},
+		{
+			name:     "asctime date",
+			value:    "Sun Nov  6 08:49:37 1994",
+			format:   "http_date",
+			expected: "1994-11-06T08:49:37Z",
+		},
+		{
+			name:      "day name does not match",
+			value:     "Tue, 15 Jan 2024 10:30:00 GMT",
+			format:    "http_date",
+			expectErr: "2024-01-15 is a Monday",
+		},
+		{
+			name:      "HTTP-date not in GMT",
+			value:     "Mon, 15 Jan 2024 10:30:00 +0000",
+			format:    "http_date",
+			expectErr: "is not an HTTP-date",
+		},
+		{
+			name:     "RFC 3164 timestamp",
+			value:    "Jan 15 10:30:00",
+			format:   "syslog_rfc3164",
+			options:  []string{"", "2024-01-20T00:00:00Z"},
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:     "RFC 3164 message",
+			value:    "<34>Oct 11 22:14:15 mymachine su: 'su root' failed for lonvick on /dev/pts/8",
+			format:   "syslog_rfc3164",
+			options:  []string{"", "2024-10-20"},
+			expected: "2024-10-11T22:14:15Z",
+		},
+		{
+			name:     "RFC 3164 space padded day in a time zone",
+			value:    "Jul  5 10:30:00",
+			format:   "syslog_rfc3164",
+			options:  []string{"Europe/Berlin", "2024-07-20T00:00:00Z"},
+			expected: "2024-07-05T10:30:00+02:00",
+		},
+		{
+			name:     "nearest year is the previous year",
+			value:    "Dec 31 23:59:59",
+			format:   "syslog_rfc3164",
+			options:  []string{"", "2024-01-01T00:10:00Z"},
+			expected: "2023-12-31T23:59:59Z",
+		},
+		{
+			name:     "nearest year is the next year",
+			value:    "Jan  1 00:00:05",
+			format:   "syslog_rfc3164",
+			options:  []string{"", "2023-12-31T23:59:00Z"},
+			expected: "2024-01-01T00:00:05Z",
+		},
+		{
+			name:     "past year inference",
+			value:    "Jan  1 00:00:05",
+			format:   "syslog_rfc3164",
+			options:  []string{"", "2023-12-31T23:59:00Z", "past"},
+			expected: "2023-01-01T00:00:05Z",
+		},
+		{
+			name:     "current year inference",
+			value:    "Dec 31 23:59:59",
+			format:   "syslog_rfc3164",
+			options:  []string{"", "2024-01-01T00:10:00Z", "current"},
+			expected: "2024-12-31T23:59:59Z",
+		},
+		{
+			name:     "current year in the time zone",
+			value:    "Dec 31 23:59:59",
+			format:   "syslog_rfc3164",
+			options:  []string{"Asia/Tokyo", "2023-12-31T20:00:00Z", "current"},
+			expected: "2024-12-31T23:59:59+09:00",
+		},
+		{
+			name:     "leap day in the past",
+			value:    "Feb 29 12:00:00",
+			format:   "syslog_rfc3164",
+			options:  []string{"", "2025-06-01", "past"},
+			expected: "2024-02-29T12:00:00Z",
+		},
+		{
+			name:     "leap day nearest",
+			value:    "Feb 29 12:00:00",
+			format:   "syslog_rfc3164",
+			options:  []string{"", "2025-01-01"},
+			expected: "2024-02-29T12:00:00Z",
+		},
+		{
+			name:      "leap day in a common year",
+			value:     "Feb 29 12:00:00",
+			format:    "syslog_rfc3164",
+			options:   []string{"", "2025-06-01", "current"},
+			expectErr: "is not a valid date and time near 2025-06-01T00:00:00Z",
+		},
+		{
+			name:      "RFC 3164 without reference",
+			value:     "Jan 15 10:30:00",
+			format:    "syslog_rfc3164",
+			expectErr: "a reference timestamp is required",
+		},
+		{
+			name:      "RFC 3164 invalid timestamp",
+			value:     "2024-01-15 10:30:00",
+			format:    "syslog_rfc3164",
+			options:   []string{"", "2024-01-20"},
+			expectErr: "RFC 3164 timestamp",
+		},
+		{
+			name:      "invalid year inference",
+			value:     "Jan 15 10:30:00",
+			format:    "syslog_rfc3164",
+			options:   []string{"", "2024-01-20", "future"},
+			expectErr: "Invalid year inference",
+		},
+		{
+			name:     "RFC 5424 timestamp",
+			value:    "2003-10-11T22:14:15.003Z",
+			format:   "syslog_rfc5424",
+			expected: "2003-10-11T22:14:15.003Z",
+		},
+		{
+			name:     "RFC 5424 timestamp with offset",
+			value:    "2003-08-24T05:14:15.000003-07:00",
+			format:   "syslog_rfc5424",
+			expected: "2003-08-24T05:14:15.000003-07:00",
+		},
+		{
+			name:     "RFC 5424 message",
+			value:    "<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 - An application event",
+			format:   "syslog_rfc5424",
+			expected: "2003-10-11T22:14:15.003Z",
+		},
+		{
+			name:      "RFC 5424 message without timestamp",
+			value:     "<165>1 - mymachine.example.com evntslog - ID47 - An application event",
+			format:    "syslog_rfc5424",
+			expectErr: "NILVALUE",
+		},
+		{
+			name:      "RFC 5424 fraction too long",
+			value:     "2003-10-11T22:14:15.0000003Z",
+			format:    "syslog_rfc5424",
+			expectErr: "is not an RFC 5424 timestamp",
+		},
+		{
+			name:      "RFC 5424 lower case separator",
+			value:     "2003-10-11t22:14:15Z",
+			format:    "syslog_rfc5424",
+			expectErr: "is not an RFC 5424 timestamp",
+		},
+		{
+			name:     "common log format",
+			value:    "15/Jan/2024:10:30:00 +0000",
+			format:   "clf",
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:     "common log format in brackets",
+			value:    "[10/Oct/2000:13:55:36 -0700]",
+			format:   "clf",
+			expected: "2000-10-10T13:55:36-07:00",
+		},
+		{
+			name:      "common log format without offset",
+			value:     "15/Jan/2024:10:30:00",
+			format:    "clf",
+			expectErr: "is not a Common Log Format timestamp",
+		},
+		{
+			name:     "GeneralizedTime in a time zone",
+			value:    "20240115103000",
+			format:   "generalized_time",
+			options:  []string{"Asia/Tokyo"},
+			expected: "2024-01-15T10:30:00+09:00",
+		},
+		{
+			name:     "UTCTime",
+			value:    "240115103000Z",
+			format:   "utctime",
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:     "Unix seconds",
+			value:    "1705314600",
+			format:   "unix",
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:      "format is not detected",
+			value:     "1705314600",
+			format:    "rfc3339",
+			expectErr: "Invalid timestamp",
+		},
+		{
+			name:      "unknown format",
+			value:     "2024-01-15T10:30:00Z",
+			format:    "iso8601",
+			expectErr: "Invalid format: unknown format \"iso8601\"",
+		},
+		{
+			name:      "invalid time zone",
+			value:     "Jan 15 10:30:00",
+			format:    "syslog_rfc3164",
+			options:   []string{"Mars/Olympus", "2024-01-20"},
+			expectErr: "Invalid time zone",
+		},
+		{
+			name:      "invalid reference",
+			value:     "Jan 15 10:30:00",
+			format:    "syslog_rfc3164",
+			options:   []string{"", "last week"},
+			expectErr: "Invalid reference timestamp",
+		},
+		{
+			name:      "too many options",
+			value:     "Jan 15 10:30:00",
+			format:    "syslog_rfc3164",
+			options:   []string{"", "2024-01-20", "past", "extra"},
+			expectErr: "Invalid options",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewParseTimestampFunction(),
+				types.StringValue(tc.value), types.StringValue(tc.format), variadicStrings(tc.options...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := result.(types.String).ValueString(); got != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseUTCTimeFunction{}
+
+type ParseUTCTimeFunction struct{}
+
+func NewParseUTCTimeFunction() function.Function {
+	return &ParseUTCTimeFunction{}
+}
+
+func (f *ParseUTCTimeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_utctime"
+}
+
+func (f *ParseUTCTimeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an ASN.1 UTCTime into RFC3339",
+		Description: "Parses an ASN.1 UTCTime as used by X.509 certificates (e.g., '240115103000Z') and returns it as an RFC3339 timestamp in the value's own offset. " +
+			"Seconds may be omitted, and the value must end in Z or a +HHMM offset. " +
+			"Two-digit years are read with the RFC 5280 window: 50 to 99 are 1950 to 1999 and 00 to 49 are 2000 to 2049.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "UTCTime value (e.g., '240115103000Z')",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ParseUTCTimeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseUTCTime(value)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid UTCTime: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(t)))
+}
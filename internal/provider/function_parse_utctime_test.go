@@ -0,0 +1,99 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseUTCTimeFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		value     string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:     "X.509 form",
+			value:    "240115103000Z",
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:     "without seconds",
+			value:    "2401151030Z",
+			expected: "2024-01-15T10:30:00Z",
+		},
+		{
+			name:     "with an offset",
+			value:    "240115103000-0500",
+			expected: "2024-01-15T10:30:00-05:00",
+		},
+		{
+			name:     "year 49 is 2049",
+			value:    "491231235959Z",
+			expected: "2049-12-31T23:59:59Z",
+		},
+		{
+			name:     "year 50 is 1950",
+			value:    "500101000000Z",
+			expected: "1950-01-01T00:00:00Z",
+		},
+		{
+			name:     "year 99 is 1999",
+			value:    "991231235959Z",
+			expected: "1999-12-31T23:59:59Z",
+		},
+		{
+			name:     "leap day",
+			value:    "000229120000Z",
+			expected: "2000-02-29T12:00:00Z",
+		},
+		{
+			name:      "suffix required",
+			value:     "240115103000",
+			expectErr: "Invalid UTCTime",
+		},
+		{
+			name:      "fraction not allowed",
+			value:     "240115103000.5Z",
+			expectErr: "Invalid UTCTime",
+		},
+		{
+			name:      "GeneralizedTime rejected",
+			value:     "20240115103000Z",
+			expectErr: "Invalid UTCTime",
+		},
+		{
+			name:      "out of range month",
+			value:     "241315103000Z",
+			expectErr: "out of range",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewParseUTCTimeFunction(), types.StringValue(tc.value))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got := result.(types.String).ValueString(); got != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
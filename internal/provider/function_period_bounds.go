@@ -0,0 +1,113 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &PeriodBoundsFunction{}
+
+type PeriodBoundsFunction struct{}
+
+func NewPeriodBoundsFunction() function.Function {
+	return &PeriodBoundsFunction{}
+}
+
+func (f *PeriodBoundsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "period_bounds"
+}
+
+func (f *PeriodBoundsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Get the start and end of the period containing a timestamp",
+		Description: "Returns a JSON object describing the period containing the timestamp: start, end (exclusive, the start of the next period), end_inclusive (one nanosecond before end), " +
+			"days (calendar days in the period) and duration_seconds. " +
+			"The period is a calendar unit (second, minute, hour, day, week, month, quarter or year), or 'fiscal_year:<month>' or 'fiscal_quarter:<month>' for fiscal periods of a year starting on the first of that month (e.g., 'fiscal_year:april'). " +
+			"Optional arguments are an IANA time zone whose wall clock defines the period, defaulting to the timestamp's own offset when omitted or empty, " +
+			"and the day weeks start on, defaulting to monday.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "RFC3339 formatted timestamp",
+			},
+			function.StringParameter{
+				Name:        "period",
+				Description: "Period name (e.g., 'quarter' or 'fiscal_year:july')",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "options",
+			Description: "Optional IANA time zone name followed by an optional week start day (e.g., 'Europe/London', 'sunday')",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *PeriodBoundsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp, periodName string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &periodName, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(options) > 2 {
+		resp.Error = function.NewFuncError("Invalid options: expected at most a time zone and a week start day")
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamp: " + err.Error())
+		return
+	}
+
+	period, err := parsePeriod(periodName)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid period: " + err.Error())
+		return
+	}
+
+	loc, err := optionalLocation(options[:min(len(options), 1)], t)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+		return
+	}
+
+	weekStart := time.Monday
+	if len(options) == 2 {
+		if weekStart, err = parseWeekday(options[1]); err != nil {
+			resp.Error = function.NewFuncError("Invalid week start: " + err.Error())
+			return
+		}
+	}
+
+	start, end, err := period.bounds(t, loc, weekStart)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid period: " + err.Error())
+		return
+	}
+
+	result, err := json.Marshal(map[string]string{
+		"start":            formatTimestamp(start),
+		"end":              formatTimestamp(end),
+		"end_inclusive":    formatTimestamp(end.Add(-time.Nanosecond)),
+		"days":             strconv.Itoa(calendarDays(start, end)),
+		"duration_seconds": strconv.FormatInt(int64(end.Sub(start)/time.Second), 10),
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to encode period bounds: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(string(result)))
+}
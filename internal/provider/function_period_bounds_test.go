@@ -0,0 +1,181 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPeriodBoundsFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		timestamp string
+		period    string
+		options   []string
+		expected  map[string]string
+		expectErr string
+	}{
+		{
+			name:      "quarter",
+			timestamp: "2024-05-15T12:00:00Z",
+			period:    "quarter",
+			expected: map[string]string{
+				"start":            "2024-04-01T00:00:00Z",
+				"end":              "2024-07-01T00:00:00Z",
+				"end_inclusive":    "2024-06-30T23:59:59.999999999Z",
+				"days":             "91",
+				"duration_seconds": "7862400",
+			},
+		},
+		{
+			name:      "day with daylight saving transition is 23 hours",
+			timestamp: "2024-03-10T12:00:00-04:00",
+			period:    "day",
+			options:   []string{"America/New_York"},
+			expected: map[string]string{
+				"start":            "2024-03-10T00:00:00-05:00",
+				"end":              "2024-03-11T00:00:00-04:00",
+				"days":             "1",
+				"duration_seconds": "82800",
+			},
+		},
+		{
+			name:      "month ending after daylight saving ends",
+			timestamp: "2024-10-15T12:00:00Z",
+			period:    "month",
+			options:   []string{"Europe/London"},
+			expected: map[string]string{
+				"start":            "2024-10-01T00:00:00+01:00",
+				"end":              "2024-11-01T00:00:00Z",
+				"end_inclusive":    "2024-10-31T23:59:59.999999999Z",
+				"days":             "31",
+				"duration_seconds": "2682000",
+			},
+		},
+		{
+			name:      "week starts on monday by default",
+			timestamp: "2024-01-14T09:00:00Z",
+			period:    "week",
+			expected: map[string]string{
+				"start": "2024-01-08T00:00:00Z",
+				"end":   "2024-01-15T00:00:00Z",
+				"days":  "7",
+			},
+		},
+		{
+			name:      "week starting sunday with default time zone",
+			timestamp: "2024-01-17T09:00:00Z",
+			period:    "week",
+			options:   []string{"", "sunday"},
+			expected: map[string]string{
+				"start": "2024-01-14T00:00:00Z",
+				"end":   "2024-01-21T00:00:00Z",
+			},
+		},
+		{
+			name:      "fiscal year starting april",
+			timestamp: "2024-02-10T00:00:00Z",
+			period:    "fiscal_year:april",
+			expected: map[string]string{
+				"start": "2023-04-01T00:00:00Z",
+				"end":   "2024-04-01T00:00:00Z",
+				"days":  "366",
+			},
+		},
+		{
+			name:      "fiscal year by month number",
+			timestamp: "2024-04-01T00:00:00Z",
+			period:    "fiscal_year:4",
+			expected: map[string]string{
+				"start": "2024-04-01T00:00:00Z",
+				"end":   "2025-04-01T00:00:00Z",
+				"days":  "365",
+			},
+		},
+		{
+			name:      "fiscal quarter spanning calendar years",
+			timestamp: "2024-01-15T00:00:00Z",
+			period:    "fiscal_quarter:feb",
+			expected: map[string]string{
+				"start": "2023-11-01T00:00:00Z",
+				"end":   "2024-02-01T00:00:00Z",
+				"days":  "92",
+			},
+		},
+		{
+			name:      "unknown period",
+			timestamp: "2024-01-15T00:00:00Z",
+			period:    "decade",
+			expectErr: "unknown period",
+		},
+		{
+			name:      "fiscal year without start month",
+			timestamp: "2024-01-15T00:00:00Z",
+			period:    "fiscal_year",
+			expectErr: "unknown period",
+		},
+		{
+			name:      "unknown fiscal start month",
+			timestamp: "2024-01-15T00:00:00Z",
+			period:    "fiscal_year:smarch",
+			expectErr: "unknown month",
+		},
+		{
+			name:      "unknown week start",
+			timestamp: "2024-01-15T00:00:00Z",
+			period:    "week",
+			options:   []string{"UTC", "funday"},
+			expectErr: "unknown weekday",
+		},
+		{
+			name:      "too many options",
+			timestamp: "2024-01-15T00:00:00Z",
+			period:    "week",
+			options:   []string{"UTC", "monday", "extra"},
+			expectErr: "at most a time zone and a week start day",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewPeriodBoundsFunction(), types.StringValue(tc.timestamp), types.StringValue(tc.period), variadicStrings(tc.options...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.timestamp)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.timestamp, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			var actual map[string]string
+			if err := json.Unmarshal([]byte(got.ValueString()), &actual); err != nil {
+				t.Errorf("Failed to parse result JSON: %v", err)
+				return
+			}
+
+			for key, expectedValue := range tc.expected {
+				if actual[key] != expectedValue {
+					t.Errorf("Expected %s=%q, got %s=%q", key, expectedValue, key, actual[key])
+				}
+			}
+		})
+	}
+}
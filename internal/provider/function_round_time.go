@@ -0,0 +1,82 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &RoundTimeFunction{}
+
+type RoundTimeFunction struct{}
+
+func NewRoundTimeFunction() function.Function {
+	return &RoundTimeFunction{}
+}
+
+func (f *RoundTimeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "round_time"
+}
+
+func (f *RoundTimeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Round a timestamp to a step or calendar unit",
+		Description: "Returns the RFC3339 timestamp rounded to a step, which is either a duration that divides a day evenly (e.g., '15m', '6h'), counted from local midnight, " +
+			"or a calendar unit (second, minute, hour, day, week, month, quarter or year). " +
+			"The mode is nearest (halfway rounds up), floor or ceil. " +
+			"Rounding uses the wall clock of the optional IANA time zone, defaulting to the timestamp's own offset, and the result is in that zone.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "RFC3339 formatted timestamp",
+			},
+			function.StringParameter{
+				Name:        "step",
+				Description: "Duration (e.g., '15m') or calendar unit (e.g., 'hour')",
+			},
+			function.StringParameter{
+				Name:        "mode",
+				Description: "Rounding mode: nearest, floor or ceil",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "timezone",
+			Description: "Optional IANA time zone name (e.g., 'Europe/London')",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RoundTimeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp, step, mode string
+	var zones []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &step, &mode, &zones))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamp: " + err.Error())
+		return
+	}
+
+	loc, err := optionalLocation(zones, t)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+		return
+	}
+
+	rounded, err := roundTime(t, step, mode, loc)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid rounding: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(rounded)))
+}
@@ -0,0 +1,185 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRoundTimeFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		timestamp string
+		step      string
+		mode      string
+		zones     []string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:      "nearest 15 minutes rounds down",
+			timestamp: "2024-01-15T14:37:12Z",
+			step:      "15m",
+			mode:      "nearest",
+			expected:  "2024-01-15T14:30:00Z",
+		},
+		{
+			name:      "nearest 15 minutes rounds up",
+			timestamp: "2024-01-15T14:38:00Z",
+			step:      "15m",
+			mode:      "nearest",
+			expected:  "2024-01-15T14:45:00Z",
+		},
+		{
+			name:      "halfway rounds up",
+			timestamp: "2024-01-15T14:37:30Z",
+			step:      "15m",
+			mode:      "nearest",
+			expected:  "2024-01-15T14:45:00Z",
+		},
+		{
+			name:      "floor",
+			timestamp: "2024-01-15T14:44:59Z",
+			step:      "15m",
+			mode:      "floor",
+			expected:  "2024-01-15T14:30:00Z",
+		},
+		{
+			name:      "ceil",
+			timestamp: "2024-01-15T14:30:01Z",
+			step:      "15m",
+			mode:      "ceil",
+			expected:  "2024-01-15T14:45:00Z",
+		},
+		{
+			name:      "ceil of boundary is unchanged",
+			timestamp: "2024-01-15T14:30:00Z",
+			step:      "15m",
+			mode:      "ceil",
+			expected:  "2024-01-15T14:30:00Z",
+		},
+		{
+			name:      "ceil crosses midnight",
+			timestamp: "2024-01-15T23:50:00Z",
+			step:      "15m",
+			mode:      "ceil",
+			expected:  "2024-01-16T00:00:00Z",
+		},
+		{
+			name:      "hour in half hour offset zone",
+			timestamp: "2024-01-15T10:10:00Z",
+			step:      "hour",
+			mode:      "nearest",
+			zones:     []string{"Asia/Kolkata"},
+			expected:  "2024-01-15T16:00:00+05:30",
+		},
+		{
+			name:      "nearest day",
+			timestamp: "2024-01-15T13:00:00Z",
+			step:      "day",
+			mode:      "nearest",
+			expected:  "2024-01-16T00:00:00Z",
+		},
+		{
+			name:      "nearest month",
+			timestamp: "2024-02-20T00:00:00Z",
+			step:      "month",
+			mode:      "nearest",
+			expected:  "2024-03-01T00:00:00Z",
+		},
+		{
+			name:      "wall clock step across daylight saving transition",
+			timestamp: "2024-03-10T01:30:00-05:00",
+			step:      "6h",
+			mode:      "ceil",
+			zones:     []string{"America/New_York"},
+			expected:  "2024-03-10T06:00:00-04:00",
+		},
+		{
+			name:      "ceil into skipped time",
+			timestamp: "2024-03-10T01:50:00-05:00",
+			step:      "15m",
+			mode:      "ceil",
+			zones:     []string{"America/New_York"},
+			expected:  "2024-03-10T03:00:00-04:00",
+		},
+		{
+			name:      "floor in repeated hour",
+			timestamp: "2024-11-03T06:40:00Z",
+			step:      "15m",
+			mode:      "floor",
+			zones:     []string{"America/New_York"},
+			expected:  "2024-11-03T01:30:00-05:00",
+		},
+		{
+			name:      "ceil in repeated hour",
+			timestamp: "2024-11-03T06:40:00Z",
+			step:      "15m",
+			mode:      "ceil",
+			zones:     []string{"America/New_York"},
+			expected:  "2024-11-03T01:45:00-05:00",
+		},
+		{
+			name:      "step that does not divide a day",
+			timestamp: "2024-01-15T14:37:12Z",
+			step:      "7m",
+			mode:      "nearest",
+			expectErr: "divides a day evenly",
+		},
+		{
+			name:      "negative step",
+			timestamp: "2024-01-15T14:37:12Z",
+			step:      "-1h",
+			mode:      "nearest",
+			expectErr: "positive duration",
+		},
+		{
+			name:      "unknown step",
+			timestamp: "2024-01-15T14:37:12Z",
+			step:      "fortnight",
+			mode:      "nearest",
+			expectErr: "neither a unit",
+		},
+		{
+			name:      "unknown mode",
+			timestamp: "2024-01-15T14:37:12Z",
+			step:      "15m",
+			mode:      "up",
+			expectErr: "unknown mode",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewRoundTimeFunction(), types.StringValue(tc.timestamp), types.StringValue(tc.step), types.StringValue(tc.mode), variadicStrings(tc.zones...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.timestamp)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.timestamp, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &TAIToUTCFunction{}
+
+type TAIToUTCFunction struct{}
+
+func NewTAIToUTCFunction() function.Function {
+	return &TAIToUTCFunction{}
+}
+
+func (f *TAIToUTCFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tai_to_utc"
+}
+
+func (f *TAIToUTCFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert TAI timestamp to UTC",
+		Description: "Converts an International Atomic Time (TAI) clock reading, formatted as RFC3339, into a UTC timestamp by subtracting the accumulated leap seconds. Readings that fall within an inserted leap second are returned with a seconds field of 60.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "TAI clock reading formatted as an RFC3339 timestamp",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "leap_seconds_list",
+			Description: "Optional contents of an IERS leap-seconds.list file to use instead of the built-in leap second table",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TAIToUTCFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+	var leapSecondsList []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &leapSecondsList))
+	if resp.Error != nil {
+		return
+	}
+
+	table, err := leapSecondTableFromArgs(leapSecondsList)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid leap-seconds.list: " + err.Error())
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid RFC3339 timestamp: " + err.Error())
+		return
+	}
+
+	utc, leap, err := table.taiToUTC(t)
+	if err != nil {
+		resp.Error = function.NewFuncError("Cannot convert TAI to UTC: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatUTCTimestamp(utc, leap)))
+}
@@ -0,0 +1,90 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTAIToUTCFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		overrides []string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "current offset",
+			input:    "2024-01-18T18:00:37Z",
+			expected: "2024-01-18T18:00:00Z",
+		},
+		{
+			name:     "before leap second",
+			input:    "2017-01-01T00:00:35Z",
+			expected: "2016-12-31T23:59:59Z",
+		},
+		{
+			name:     "inserted leap second",
+			input:    "2017-01-01T00:00:36Z",
+			expected: "2016-12-31T23:59:60Z",
+		},
+		{
+			name:     "fractional leap second",
+			input:    "2017-01-01T00:00:36.5Z",
+			expected: "2016-12-31T23:59:60.5Z",
+		},
+		{
+			name:     "after leap second",
+			input:    "2017-01-01T00:00:37Z",
+			expected: "2017-01-01T00:00:00Z",
+		},
+		{
+			name:      "override table",
+			input:     "2024-01-18T18:00:36Z",
+			overrides: []string{testLeapSecondsList},
+			expected:  "2024-01-18T18:00:00Z",
+		},
+		{
+			name:      "before table",
+			input:     "1972-01-01T00:00:09Z",
+			expectErr: true,
+		},
+		{
+			name:      "invalid timestamp",
+			input:     "invalid",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewTAIToUTCFunction(), types.StringValue(tc.input), variadicStrings(tc.overrides...))
+
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
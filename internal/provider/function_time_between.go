@@ -0,0 +1,81 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &TimeBetweenFunction{}
+
+type TimeBetweenFunction struct{}
+
+func NewTimeBetweenFunction() function.Function {
+	return &TimeBetweenFunction{}
+}
+
+func (f *TimeBetweenFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "time_between"
+}
+
+func (f *TimeBetweenFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a timestamp is between two others",
+		Description: "Returns true if the timestamp is at or after start and before end, comparing instants regardless of UTC offsets. " +
+			"The range is half-open like the interval functions, so a timestamp equal to end is not between. " +
+			"Each timestamp may be " + timestampFormatSummary + "; dates without a time are midnight UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "Timestamp to check",
+			},
+			function.StringParameter{
+				Name:        "start",
+				Description: "Start of the range (included)",
+			},
+			function.StringParameter{
+				Name:        "end",
+				Description: "End of the range (excluded)",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *TimeBetweenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp, startTimestamp, endTimestamp string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &startTimestamp, &endTimestamp))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseAnyTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamp: " + err.Error())
+		return
+	}
+
+	start, err := parseAnyTimestamp(startTimestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid start timestamp: " + err.Error())
+		return
+	}
+
+	end, err := parseAnyTimestamp(endTimestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid end timestamp: " + err.Error())
+		return
+	}
+
+	if end.Before(start) {
+		resp.Error = function.NewFuncError("Invalid range: end timestamp is before start timestamp")
+		return
+	}
+
+	resp.Result = function.NewResultData(types.BoolValue(interval{start: start, end: end}.contains(t)))
+}
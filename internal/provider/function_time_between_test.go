@@ -0,0 +1,109 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimeBetweenFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		timestamp string
+		start     string
+		end       string
+		expected  bool
+		expectErr string
+	}{
+		{
+			name:      "inside with mixed offsets",
+			timestamp: "2024-01-15T10:30:00+02:00",
+			start:     "2024-01-15T08:00:00Z",
+			end:       "2024-01-15T09:00:00Z",
+			expected:  true,
+		},
+		{
+			name:      "lexically inside but before start",
+			timestamp: "2024-01-15T08:30:00+01:00",
+			start:     "2024-01-15T08:00:00Z",
+			end:       "2024-01-15T09:00:00Z",
+			expected:  false,
+		},
+		{
+			name:      "start is included",
+			timestamp: "2024-01-15T09:00:00+01:00",
+			start:     "2024-01-15T08:00:00Z",
+			end:       "2024-01-15T09:00:00Z",
+			expected:  true,
+		},
+		{
+			name:      "end is excluded",
+			timestamp: "2024-01-15T10:00:00+01:00",
+			start:     "2024-01-15T08:00:00Z",
+			end:       "2024-01-15T09:00:00Z",
+			expected:  false,
+		},
+		{
+			name:      "dates",
+			timestamp: "2024-W03-3",
+			start:     "2024-01-15",
+			end:       "2024-01-22",
+			expected:  true,
+		},
+		{
+			name:      "empty range",
+			timestamp: "2024-01-15",
+			start:     "2024-01-15",
+			end:       "2024-01-15",
+			expected:  false,
+		},
+		{
+			name:      "end before start",
+			timestamp: "2024-01-15",
+			start:     "2024-01-16",
+			end:       "2024-01-15",
+			expectErr: "Invalid range",
+		},
+		{
+			name:      "invalid start",
+			timestamp: "2024-01-15",
+			start:     "later",
+			end:       "2024-01-16",
+			expectErr: "Invalid start timestamp",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewTimeBetweenFunction(), types.StringValue(tc.timestamp), types.StringValue(tc.start), types.StringValue(tc.end))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for %q, but got none", tc.timestamp)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for %q: %v", tc.timestamp, err)
+				return
+			}
+
+			got, ok := result.(types.Bool)
+			if !ok {
+				t.Errorf("Expected types.Bool, got %T", result)
+				return
+			}
+
+			if got.ValueBool() != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, got.ValueBool())
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &TimeCompareFunction{}
+
+type TimeCompareFunction struct{}
+
+func NewTimeCompareFunction() function.Function {
+	return &TimeCompareFunction{}
+}
+
+func (f *TimeCompareFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "time_compare"
+}
+
+func (f *TimeCompareFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compare two timestamps by instant",
+		Description: "Returns '-1' if a is before b, '0' if they are the same instant and '1' if a is after b, regardless of their UTC offsets. " +
+			"Each timestamp may be " + timestampFormatSummary + "; dates without a time are midnight UTC.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "a",
+				Description: "First timestamp (e.g., '2024-01-15T10:30:00+01:00')",
+			},
+			function.StringParameter{
+				Name:        "b",
+				Description: "Second timestamp (e.g., '2024-01-15T09:30:00Z')",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TimeCompareFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var valueA, valueB string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &valueA, &valueB))
+	if resp.Error != nil {
+		return
+	}
+
+	a, err := parseAnyTimestamp(valueA)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamp a: " + err.Error())
+		return
+	}
+
+	b, err := parseAnyTimestamp(valueB)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamp b: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(strconv.Itoa(a.Compare(b))))
+}
@@ -0,0 +1,100 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimeCompareFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		a         string
+		b         string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:     "lexically later but earlier instant",
+			a:        "2024-01-15T10:30:00+02:00",
+			b:        "2024-01-15T09:00:00Z",
+			expected: "-1",
+		},
+		{
+			name:     "same instant in different offsets",
+			a:        "2024-01-15T10:30:00+01:00",
+			b:        "2024-01-15T09:30:00Z",
+			expected: "0",
+		},
+		{
+			name:     "later",
+			a:        "2024-01-15T09:30:00.5Z",
+			b:        "2024-01-15T09:30:00Z",
+			expected: "1",
+		},
+		{
+			name:     "date against timestamp",
+			a:        "2024-01-15",
+			b:        "2024-01-15T00:00:00Z",
+			expected: "0",
+		},
+		{
+			name:     "unix seconds against ISO week date",
+			a:        "1705276800",
+			b:        "2024-W03-1",
+			expected: "0",
+		},
+		{
+			name:     "ordinal date",
+			a:        "2024-016",
+			b:        "2024-01-15",
+			expected: "1",
+		},
+		{
+			name:      "invalid a",
+			a:         "yesterday",
+			b:         "2024-01-15",
+			expectErr: "Invalid timestamp a",
+		},
+		{
+			name:      "invalid b",
+			a:         "2024-01-15",
+			b:         "2024-13-01",
+			expectErr: "Invalid timestamp b",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewTimeCompareFunction(), types.StringValue(tc.a), types.StringValue(tc.b))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for %q and %q, but got none", tc.a, tc.b)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for %q and %q: %v", tc.a, tc.b, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
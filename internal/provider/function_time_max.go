@@ -0,0 +1,56 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &TimeMaxFunction{}
+
+type TimeMaxFunction struct{}
+
+func NewTimeMaxFunction() function.Function {
+	return &TimeMaxFunction{}
+}
+
+func (f *TimeMaxFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "time_max"
+}
+
+func (f *TimeMaxFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Get the latest timestamp in a list",
+		Description: "Returns the timestamp in the list that represents the latest instant, unchanged, or the first of several at that instant. " +
+			"Each timestamp may be " + timestampFormatSummary + "; dates without a time are midnight UTC. An empty list is an error.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "timestamps",
+				Description: "List of timestamps",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TimeMaxFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var values []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &values))
+	if resp.Error != nil {
+		return
+	}
+
+	i, err := extremeTimestamp(values, 1)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamps: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(values[i]))
+}
@@ -0,0 +1,86 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimeMaxFunction(t *testing.T) {
+	testCases := []struct {
+		name       string
+		timestamps []string
+		expected   string
+		expectErr  string
+	}{
+		{
+			name: "mixed offsets",
+			timestamps: []string{
+				"2024-01-15T09:00:00Z",
+				"2024-01-15T10:30:00+02:00",
+				"2024-01-15T03:00:00-05:00",
+			},
+			expected: "2024-01-15T09:00:00Z",
+		},
+		{
+			name:       "first of equal instants",
+			timestamps: []string{"2024-01-14", "2024-01-15T01:00:00+01:00", "2024-01-15"},
+			expected:   "2024-01-15T01:00:00+01:00",
+		},
+		{
+			name:       "single element",
+			timestamps: []string{"1705276800"},
+			expected:   "1705276800",
+		},
+		{
+			name:       "empty list",
+			timestamps: []string{},
+			expectErr:  "must not be empty",
+		},
+		{
+			name:       "invalid element",
+			timestamps: []string{"2024-01-15", "2024-02-30"},
+			expectErr:  "element 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			elems := make([]attr.Value, len(tc.timestamps))
+			for i, ts := range tc.timestamps {
+				elems[i] = types.StringValue(ts)
+			}
+
+			result, err := runFunction(t, NewTimeMaxFunction(), types.ListValueMust(types.StringType, elems))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for %q, but got none", tc.timestamps)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for %q: %v", tc.timestamps, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
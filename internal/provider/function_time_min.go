@@ -0,0 +1,56 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &TimeMinFunction{}
+
+type TimeMinFunction struct{}
+
+func NewTimeMinFunction() function.Function {
+	return &TimeMinFunction{}
+}
+
+func (f *TimeMinFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "time_min"
+}
+
+func (f *TimeMinFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Get the earliest timestamp in a list",
+		Description: "Returns the timestamp in the list that represents the earliest instant, unchanged, or the first of several at that instant. " +
+			"Each timestamp may be " + timestampFormatSummary + "; dates without a time are midnight UTC. An empty list is an error.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "timestamps",
+				Description: "List of timestamps",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TimeMinFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var values []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &values))
+	if resp.Error != nil {
+		return
+	}
+
+	i, err := extremeTimestamp(values, -1)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamps: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(values[i]))
+}
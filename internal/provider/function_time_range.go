@@ -0,0 +1,125 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultTimeRangeMaxCount limits time_range results unless a larger
+// max_count is given, so a mistyped step cannot produce a huge plan.
+const defaultTimeRangeMaxCount = 1000
+
+var _ function.Function = &TimeRangeFunction{}
+
+type TimeRangeFunction struct{}
+
+func NewTimeRangeFunction() function.Function {
+	return &TimeRangeFunction{}
+}
+
+func (f *TimeRangeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "time_range"
+}
+
+func (f *TimeRangeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generate a sequence of timestamps",
+		Description: "Returns a list of RFC3339 timestamps from start (included) up to end (excluded) separated by step. " +
+			"The step is a Go duration (e.g., '36h'), an ISO 8601 duration (e.g., 'P1M' or 'PT15M') or a calendar unit with an optional count (e.g., 'day' or '2 weeks'). " +
+			"Days, weeks, months, quarters and years are added on the wall clock of the optional IANA time zone, defaulting to start's own offset, so '09:00' stays 09:00 local across daylight saving transitions; " +
+			"monthly steps from the 31st use the last day of shorter months. Hours, minutes and seconds are elapsed time. " +
+			"An optional max_count after the time zone (default 1000) is an error to exceed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "start",
+				Description: "RFC3339 formatted first timestamp",
+			},
+			function.StringParameter{
+				Name:        "end",
+				Description: "RFC3339 formatted end of the range (excluded)",
+			},
+			function.StringParameter{
+				Name:        "step",
+				Description: "Step between timestamps (e.g., '1 month', 'P1D' or '6h')",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "options",
+			Description: "Optional IANA time zone name followed by an optional max_count (e.g., 'Europe/London', '5000')",
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *TimeRangeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var startTimestamp, endTimestamp, stepValue string
+	var options []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &startTimestamp, &endTimestamp, &stepValue, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(options) > 2 {
+		resp.Error = function.NewFuncError("Invalid options: expected at most a time zone and a max_count")
+		return
+	}
+
+	start, err := parseTimestamp(startTimestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid start timestamp: " + err.Error())
+		return
+	}
+
+	end, err := parseTimestamp(endTimestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid end timestamp: " + err.Error())
+		return
+	}
+
+	step, err := parseCalendarStep(stepValue)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid step: " + err.Error())
+		return
+	}
+
+	loc, err := optionalLocation(options[:min(len(options), 1)], start)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+		return
+	}
+
+	maxCount := defaultTimeRangeMaxCount
+	if len(options) == 2 {
+		if maxCount, err = strconv.Atoi(options[1]); err != nil || maxCount < 1 {
+			resp.Error = function.NewFuncError("Invalid max_count: " + strconv.Quote(options[1]) + " is not a positive integer")
+			return
+		}
+	}
+
+	timestamps := []attr.Value{}
+	for n := 0; ; n++ {
+		t := step.after(start.In(loc), n, loc)
+		if !t.Before(end) {
+			break
+		}
+
+		if len(timestamps) == maxCount {
+			resp.Error = function.NewFuncError("Too many timestamps: the range has more than " + strconv.Itoa(maxCount) + " steps, pass a larger max_count if this is intended")
+			return
+		}
+
+		timestamps = append(timestamps, types.StringValue(formatTimestamp(t)))
+	}
+
+	resp.Result = function.NewResultData(types.ListValueMust(types.StringType, timestamps))
+}
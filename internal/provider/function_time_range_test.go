@@ -0,0 +1,215 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimeRangeFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		start     string
+		end       string
+		step      string
+		options   []string
+		expected  []string
+		count     int
+		expectErr string
+	}{
+		{
+			name:    "daily local time across daylight saving transition",
+			start:   "2024-03-09T09:00:00-05:00",
+			end:     "2024-03-12T00:00:00Z",
+			step:    "1 day",
+			options: []string{"America/New_York"},
+			expected: []string{
+				"2024-03-09T09:00:00-05:00",
+				"2024-03-10T09:00:00-04:00",
+				"2024-03-11T09:00:00-04:00",
+			},
+		},
+		{
+			name:    "go duration is elapsed time",
+			start:   "2024-03-09T09:00:00-05:00",
+			end:     "2024-03-11T00:00:00Z",
+			step:    "24h",
+			options: []string{"America/New_York"},
+			expected: []string{
+				"2024-03-09T09:00:00-05:00",
+				"2024-03-10T10:00:00-04:00",
+			},
+		},
+		{
+			name:  "monthly from the 31st clamps to month end",
+			start: "2024-01-31T00:00:00Z",
+			end:   "2024-05-01T00:00:00Z",
+			step:  "P1M",
+			expected: []string{
+				"2024-01-31T00:00:00Z",
+				"2024-02-29T00:00:00Z",
+				"2024-03-31T00:00:00Z",
+				"2024-04-30T00:00:00Z",
+			},
+		},
+		{
+			name:  "weeks keep the start offset",
+			start: "2024-01-01T12:00:00+05:30",
+			end:   "2024-02-01T00:00:00Z",
+			step:  "2 weeks",
+			expected: []string{
+				"2024-01-01T12:00:00+05:30",
+				"2024-01-15T12:00:00+05:30",
+				"2024-01-29T12:00:00+05:30",
+			},
+		},
+		{
+			name:  "quarters",
+			start: "2024-01-01T00:00:00Z",
+			end:   "2025-01-01T00:00:00Z",
+			step:  "quarter",
+			expected: []string{
+				"2024-01-01T00:00:00Z",
+				"2024-04-01T00:00:00Z",
+				"2024-07-01T00:00:00Z",
+				"2024-10-01T00:00:00Z",
+			},
+		},
+		{
+			name:  "ISO duration with date and time parts",
+			start: "2024-01-01T00:00:00Z",
+			end:   "2024-01-05T00:00:00Z",
+			step:  "P1DT12H",
+			expected: []string{
+				"2024-01-01T00:00:00Z",
+				"2024-01-02T12:00:00Z",
+				"2024-01-04T00:00:00Z",
+			},
+		},
+		{
+			name:  "ISO time duration",
+			start: "2024-01-01T00:00:00Z",
+			end:   "2024-01-01T00:45:00Z",
+			step:  "PT15M",
+			expected: []string{
+				"2024-01-01T00:00:00Z",
+				"2024-01-01T00:15:00Z",
+				"2024-01-01T00:30:00Z",
+			},
+		},
+		{
+			name:     "end before start is empty",
+			start:    "2024-01-02T00:00:00Z",
+			end:      "2024-01-01T00:00:00Z",
+			step:     "day",
+			expected: []string{},
+		},
+		{
+			name:    "max count allows exactly that many",
+			start:   "2024-01-01T00:00:00Z",
+			end:     "2024-01-02T00:00:00Z",
+			step:    "1h",
+			options: []string{"", "24"},
+			count:   24,
+		},
+		{
+			name:  "steps spanning more than 292 years",
+			start: "0001-01-01T00:00:00Z",
+			end:   "9999-01-01T00:00:00Z",
+			step:  "876000h",
+			count: 101,
+		},
+		{
+			name:      "max count exceeded",
+			start:     "2024-01-01T00:00:00Z",
+			end:       "2024-01-02T00:00:00Z",
+			step:      "1h",
+			options:   []string{"UTC", "10"},
+			expectErr: "more than 10 steps",
+		},
+		{
+			name:      "default max count",
+			start:     "2024-01-01T00:00:00Z",
+			end:       "2024-01-02T00:00:00Z",
+			step:      "1m",
+			expectErr: "more than 1000 steps",
+		},
+		{
+			name:      "invalid max count",
+			start:     "2024-01-01T00:00:00Z",
+			end:       "2024-01-02T00:00:00Z",
+			step:      "1h",
+			options:   []string{"UTC", "lots"},
+			expectErr: "Invalid max_count",
+		},
+		{
+			name:      "zero step",
+			start:     "2024-01-01T00:00:00Z",
+			end:       "2024-01-02T00:00:00Z",
+			step:      "0s",
+			expectErr: "must be positive",
+		},
+		{
+			name:      "empty ISO duration",
+			start:     "2024-01-01T00:00:00Z",
+			end:       "2024-01-02T00:00:00Z",
+			step:      "P",
+			expectErr: "Invalid step",
+		},
+		{
+			name:      "unknown unit",
+			start:     "2024-01-01T00:00:00Z",
+			end:       "2024-01-02T00:00:00Z",
+			step:      "1 fortnight",
+			expectErr: "unknown unit",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewTimeRangeFunction(), types.StringValue(tc.start), types.StringValue(tc.end), types.StringValue(tc.step), variadicStrings(tc.options...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for step %q, but got none", tc.step)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for step %q: %v", tc.step, err)
+				return
+			}
+
+			list, ok := result.(types.List)
+			if !ok {
+				t.Errorf("Expected types.List, got %T", result)
+				return
+			}
+
+			got := []string{}
+			for _, elem := range list.Elements() {
+				s, ok := elem.(types.String)
+				if !ok {
+					t.Fatalf("Expected types.String element, got %T", elem)
+				}
+				got = append(got, s.ValueString())
+			}
+
+			if tc.expected != nil && !slices.Equal(got, tc.expected) {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+
+			if tc.count != 0 && len(got) != tc.count {
+				t.Errorf("Expected %d timestamps, got %d", tc.count, len(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &TimeSortFunction{}
+
+type TimeSortFunction struct{}
+
+func NewTimeSortFunction() function.Function {
+	return &TimeSortFunction{}
+}
+
+func (f *TimeSortFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "time_sort"
+}
+
+func (f *TimeSortFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Sort timestamps by instant",
+		Description: "Returns the list of timestamps sorted by the instant they represent rather than lexically, keeping each timestamp's original text. " +
+			"Timestamps at the same instant keep their input order. An optional true sorts latest first. " +
+			"Each timestamp may be " + timestampFormatSummary + "; dates without a time are midnight UTC.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "timestamps",
+				Description: "List of timestamps",
+				ElementType: types.StringType,
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:        "descending",
+			Description: "Optional true to sort latest first",
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *TimeSortFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var values []string
+	var descending []bool
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &values, &descending))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(descending) > 1 {
+		resp.Error = function.NewFuncError("Invalid descending: at most one value may be supplied")
+		return
+	}
+
+	times, err := parseAnyTimestamps(values)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamps: " + err.Error())
+		return
+	}
+
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+
+	slices.SortStableFunc(order, func(i, j int) int {
+		if len(descending) == 1 && descending[0] {
+			return times[j].Compare(times[i])
+		}
+		return times[i].Compare(times[j])
+	})
+
+	sorted := make([]attr.Value, 0, len(order))
+	for _, i := range order {
+		sorted = append(sorted, types.StringValue(values[i]))
+	}
+
+	resp.Result = function.NewResultData(types.ListValueMust(types.StringType, sorted))
+}
@@ -0,0 +1,139 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimeSortFunction(t *testing.T) {
+	testCases := []struct {
+		name       string
+		timestamps []string
+		descending []bool
+		expected   []string
+		expectErr  string
+	}{
+		{
+			name: "mixed offsets sort by instant",
+			timestamps: []string{
+				"2024-01-15T10:30:00+02:00",
+				"2024-01-15T09:00:00Z",
+				"2024-01-15T03:00:00-05:00",
+			},
+			expected: []string{
+				"2024-01-15T03:00:00-05:00",
+				"2024-01-15T10:30:00+02:00",
+				"2024-01-15T09:00:00Z",
+			},
+		},
+		{
+			name: "descending",
+			timestamps: []string{
+				"2024-01-15T10:30:00+02:00",
+				"2024-01-15T09:00:00Z",
+				"2024-01-15T03:00:00-05:00",
+			},
+			descending: []bool{true},
+			expected: []string{
+				"2024-01-15T09:00:00Z",
+				"2024-01-15T10:30:00+02:00",
+				"2024-01-15T03:00:00-05:00",
+			},
+		},
+		{
+			name: "equal instants keep input order",
+			timestamps: []string{
+				"2024-01-15T00:00:00Z",
+				"2024-01-14",
+				"2024-01-15",
+				"2024-01-15T01:00:00+01:00",
+			},
+			expected: []string{
+				"2024-01-14",
+				"2024-01-15T00:00:00Z",
+				"2024-01-15",
+				"2024-01-15T01:00:00+01:00",
+			},
+		},
+		{
+			name:       "explicit ascending with mixed formats",
+			timestamps: []string{"1705276800", "2024-W01-1", "2024-010"},
+			descending: []bool{false},
+			expected:   []string{"2024-W01-1", "2024-010", "1705276800"},
+		},
+		{
+			name:       "empty list",
+			timestamps: []string{},
+			expected:   []string{},
+		},
+		{
+			name:       "invalid element",
+			timestamps: []string{"2024-01-15", "soon"},
+			expectErr:  "element 1",
+		},
+		{
+			name:       "too many flags",
+			timestamps: []string{"2024-01-15"},
+			descending: []bool{true, false},
+			expectErr:  "Invalid descending",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			elems := make([]attr.Value, len(tc.timestamps))
+			for i, ts := range tc.timestamps {
+				elems[i] = types.StringValue(ts)
+			}
+
+			flagTypes := make([]attr.Type, len(tc.descending))
+			flags := make([]attr.Value, len(tc.descending))
+			for i, d := range tc.descending {
+				flagTypes[i] = types.BoolType
+				flags[i] = types.BoolValue(d)
+			}
+
+			result, err := runFunction(t, NewTimeSortFunction(), types.ListValueMust(types.StringType, elems), types.TupleValueMust(flagTypes, flags))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for %q, but got none", tc.timestamps)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for %q: %v", tc.timestamps, err)
+				return
+			}
+
+			list, ok := result.(types.List)
+			if !ok {
+				t.Errorf("Expected types.List, got %T", result)
+				return
+			}
+
+			got := []string{}
+			for _, elem := range list.Elements() {
+				s, ok := elem.(types.String)
+				if !ok {
+					t.Fatalf("Expected types.String element, got %T", elem)
+				}
+				got = append(got, s.ValueString())
+			}
+
+			if !slices.Equal(got, tc.expected) {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
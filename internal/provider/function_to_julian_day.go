@@ -0,0 +1,54 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ToJulianDayFunction{}
+
+type ToJulianDayFunction struct{}
+
+func NewToJulianDayFunction() function.Function {
+	return &ToJulianDayFunction{}
+}
+
+func (f *ToJulianDayFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "to_julian_day"
+}
+
+func (f *ToJulianDayFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert timestamp to Julian Day",
+		Description: "Returns the Julian Day (days since noon UTC on 4713-01-01 BC, proleptic Julian calendar) of an RFC3339 timestamp as a decimal string with up to 9 fractional digits.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "RFC3339 formatted timestamp string",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ToJulianDayFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid RFC3339 timestamp: " + err.Error())
+		return
+	}
+
+	days := formatFractionalDays(daysSinceEpoch(t, julianDayUnixEpoch))
+	resp.Result = function.NewResultData(types.StringValue(days))
+}
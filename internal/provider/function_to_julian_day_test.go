@@ -0,0 +1,78 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestToJulianDayFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "unix epoch",
+			input:    "1970-01-01T00:00:00Z",
+			expected: "2440587.5",
+		},
+		{
+			name:     "J2000 epoch",
+			input:    "2000-01-01T12:00:00Z",
+			expected: "2451545",
+		},
+		{
+			name:     "fractional day",
+			input:    "2024-01-15T16:30:00Z",
+			expected: "2460325.1875",
+		},
+		{
+			name:     "offset is normalized",
+			input:    "2024-01-15T11:30:00-05:00",
+			expected: "2460325.1875",
+		},
+		{
+			name:     "sub-second precision",
+			input:    "2024-01-15T12:00:00.5Z",
+			expected: "2460325.000005787",
+		},
+		{
+			name:      "invalid timestamp",
+			input:     "invalid",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewToJulianDayFunction(), types.StringValue(tc.input))
+
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ToMJDFunction{}
+
+type ToMJDFunction struct{}
+
+func NewToMJDFunction() function.Function {
+	return &ToMJDFunction{}
+}
+
+func (f *ToMJDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "to_mjd"
+}
+
+func (f *ToMJDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert timestamp to Modified Julian Date",
+		Description: "Returns the Modified Julian Date (days since 1858-11-17T00:00:00Z) of an RFC3339 timestamp as a decimal string with up to 9 fractional digits.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "RFC3339 formatted timestamp string",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ToMJDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid RFC3339 timestamp: " + err.Error())
+		return
+	}
+
+	days := formatFractionalDays(daysSinceEpoch(t, mjdUnixEpoch))
+	resp.Result = function.NewResultData(types.StringValue(days))
+}
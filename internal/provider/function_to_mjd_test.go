@@ -0,0 +1,73 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestToMJDFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "MJD epoch",
+			input:    "1858-11-17T00:00:00Z",
+			expected: "0",
+		},
+		{
+			name:     "unix epoch",
+			input:    "1970-01-01T00:00:00Z",
+			expected: "40587",
+		},
+		{
+			name:     "fractional day",
+			input:    "2024-01-15T16:30:00Z",
+			expected: "60324.6875",
+		},
+		{
+			name:     "before MJD epoch",
+			input:    "1858-11-16T12:00:00Z",
+			expected: "-0.5",
+		},
+		{
+			name:      "invalid timestamp",
+			input:     "2024-01-15",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewToMJDFunction(), types.StringValue(tc.input))
+
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
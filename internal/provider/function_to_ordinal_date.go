@@ -0,0 +1,53 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ToOrdinalDateFunction{}
+
+type ToOrdinalDateFunction struct{}
+
+func NewToOrdinalDateFunction() function.Function {
+	return &ToOrdinalDateFunction{}
+}
+
+func (f *ToOrdinalDateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "to_ordinal_date"
+}
+
+func (f *ToOrdinalDateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert timestamp to ordinal date",
+		Description: "Returns the ISO 8601 ordinal date (YYYY-DDD) of an RFC3339 timestamp, using the calendar date in the timestamp's own offset.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "RFC3339 formatted timestamp string",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ToOrdinalDateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid RFC3339 timestamp: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatOrdinalDate(t)))
+}
@@ -0,0 +1,73 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestToOrdinalDateFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "start of year",
+			input:    "2024-01-01T00:00:00Z",
+			expected: "2024-001",
+		},
+		{
+			name:     "leap day",
+			input:    "2024-02-29T12:00:00Z",
+			expected: "2024-060",
+		},
+		{
+			name:     "last day of leap year",
+			input:    "2024-12-31T23:59:59Z",
+			expected: "2024-366",
+		},
+		{
+			name:     "uses local calendar date",
+			input:    "2024-01-15T23:30:00-05:00",
+			expected: "2024-015",
+		},
+		{
+			name:      "invalid timestamp",
+			input:     "invalid",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewToOrdinalDateFunction(), types.StringValue(tc.input))
+
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &TruncateTimeFunction{}
+
+type TruncateTimeFunction struct{}
+
+func NewTruncateTimeFunction() function.Function {
+	return &TruncateTimeFunction{}
+}
+
+func (f *TruncateTimeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "truncate_time"
+}
+
+func (f *TruncateTimeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Truncate a timestamp to the start of a calendar unit",
+		Description: "Returns the RFC3339 timestamp of the start of the second, minute, hour, day, week, month, quarter or year containing the timestamp. " +
+			"Weeks are ISO weeks starting on Monday and quarters start in January, April, July and October. " +
+			"Truncation uses the wall clock of the optional IANA time zone, defaulting to the timestamp's own offset, and the result is in that zone. " +
+			"A start of day skipped by a daylight saving transition resolves to the first instant after it.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "RFC3339 formatted timestamp",
+			},
+			function.StringParameter{
+				Name:        "unit",
+				Description: "Calendar unit: second, minute, hour, day, week, month, quarter or year",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "timezone",
+			Description: "Optional IANA time zone name (e.g., 'Europe/London')",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TruncateTimeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp, unit string
+	var zones []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &unit, &zones))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamp: " + err.Error())
+		return
+	}
+
+	loc, err := optionalLocation(zones, t)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+		return
+	}
+
+	truncated, err := truncateTime(t, unit, loc, time.Monday)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid unit: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(truncated)))
+}
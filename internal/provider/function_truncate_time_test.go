@@ -0,0 +1,168 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTruncateTimeFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		timestamp string
+		unit      string
+		zones     []string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:      "hour",
+			timestamp: "2024-01-15T14:37:12Z",
+			unit:      "hour",
+			expected:  "2024-01-15T14:00:00Z",
+		},
+		{
+			name:      "minute drops fractional seconds",
+			timestamp: "2024-01-15T14:37:12.5Z",
+			unit:      "minute",
+			expected:  "2024-01-15T14:37:00Z",
+		},
+		{
+			name:      "second",
+			timestamp: "2024-01-15T14:37:12.5Z",
+			unit:      "second",
+			expected:  "2024-01-15T14:37:12Z",
+		},
+		{
+			name:      "day keeps timestamp offset",
+			timestamp: "2024-01-15T01:30:00+05:30",
+			unit:      "day",
+			expected:  "2024-01-15T00:00:00+05:30",
+		},
+		{
+			name:      "day in time zone",
+			timestamp: "2024-01-15T02:00:00Z",
+			unit:      "day",
+			zones:     []string{"America/New_York"},
+			expected:  "2024-01-14T00:00:00-05:00",
+		},
+		{
+			name:      "hour in half hour offset zone",
+			timestamp: "2024-01-15T10:10:00Z",
+			unit:      "hour",
+			zones:     []string{"Asia/Kolkata"},
+			expected:  "2024-01-15T15:00:00+05:30",
+		},
+		{
+			name:      "ISO week starts on Monday",
+			timestamp: "2024-01-17T09:00:00Z",
+			unit:      "week",
+			expected:  "2024-01-15T00:00:00Z",
+		},
+		{
+			name:      "week crossing year",
+			timestamp: "2021-01-01T09:00:00Z",
+			unit:      "week",
+			expected:  "2020-12-28T00:00:00Z",
+		},
+		{
+			name:      "month",
+			timestamp: "2024-02-29T23:59:59Z",
+			unit:      "month",
+			expected:  "2024-02-01T00:00:00Z",
+		},
+		{
+			name:      "quarter",
+			timestamp: "2024-08-15T12:00:00Z",
+			unit:      "quarter",
+			expected:  "2024-07-01T00:00:00Z",
+		},
+		{
+			name:      "year",
+			timestamp: "2024-08-15T12:00:00Z",
+			unit:      "year",
+			expected:  "2024-01-01T00:00:00Z",
+		},
+		{
+			name:      "day with daylight saving transition",
+			timestamp: "2024-03-10T12:00:00-04:00",
+			unit:      "day",
+			zones:     []string{"America/New_York"},
+			expected:  "2024-03-10T00:00:00-05:00",
+		},
+		{
+			name:      "skipped midnight starts day after gap",
+			timestamp: "2024-03-10T12:00:00-04:00",
+			unit:      "day",
+			zones:     []string{"America/Havana"},
+			expected:  "2024-03-10T01:00:00-04:00",
+		},
+		{
+			name:      "repeated hour keeps second occurrence",
+			timestamp: "2024-11-03T06:40:00Z",
+			unit:      "hour",
+			zones:     []string{"America/New_York"},
+			expected:  "2024-11-03T01:00:00-05:00",
+		},
+		{
+			name:      "unknown unit",
+			timestamp: "2024-01-15T14:37:12Z",
+			unit:      "fortnight",
+			expectErr: "unknown unit",
+		},
+		{
+			name:      "unknown time zone",
+			timestamp: "2024-01-15T14:37:12Z",
+			unit:      "day",
+			zones:     []string{"Mars/Olympus_Mons"},
+			expectErr: "unknown time zone",
+		},
+		{
+			name:      "too many time zones",
+			timestamp: "2024-01-15T14:37:12Z",
+			unit:      "day",
+			zones:     []string{"UTC", "Europe/London"},
+			expectErr: "at most one time zone",
+		},
+		{
+			name:      "invalid timestamp",
+			timestamp: "2024-01-15",
+			unit:      "day",
+			expectErr: "Invalid timestamp",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewTruncateTimeFunction(), types.StringValue(tc.timestamp), types.StringValue(tc.unit), variadicStrings(tc.zones...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.timestamp)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.timestamp, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
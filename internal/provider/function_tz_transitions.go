@@ -0,0 +1,129 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultTZTransitionsMaxCount limits tz_transitions results unless a larger
+// max_count is given, so a range of centuries cannot produce a huge plan.
+const defaultTZTransitionsMaxCount = 1000
+
+var _ function.Function = &TZTransitionsFunction{}
+
+type TZTransitionsFunction struct{}
+
+func NewTZTransitionsFunction() function.Function {
+	return &TZTransitionsFunction{}
+}
+
+func (f *TZTransitionsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tz_transitions"
+}
+
+func (f *TZTransitionsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "List time zone offset transitions in a range",
+		Description: "Returns a JSON array of every UTC offset or abbreviation change in an IANA time zone after start and up to and including end. " +
+			"Each element has timestamp (RFC3339 in UTC), local_time (RFC3339 in the zone), offset, offset_seconds, abbreviation and is_dst. " +
+			"An optional max_count (default 1000) is an error to exceed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "zone",
+				Description: "IANA time zone name (e.g., 'Europe/London')",
+			},
+			function.StringParameter{
+				Name:        "start",
+				Description: "RFC3339 formatted start of the range (exclusive)",
+			},
+			function.StringParameter{
+				Name:        "end",
+				Description: "RFC3339 formatted end of the range (inclusive)",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "max_count",
+			Description: "Optional maximum number of transitions (e.g., '5000')",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TZTransitionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var zone, startTimestamp, endTimestamp string
+	var maxCounts []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &zone, &startTimestamp, &endTimestamp, &maxCounts))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(maxCounts) > 1 {
+		resp.Error = function.NewFuncError("Invalid max_count: expected at most one max_count")
+		return
+	}
+
+	loc, err := loadLocation(zone)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid time zone: " + err.Error())
+		return
+	}
+
+	start, err := parseTimestamp(startTimestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid start timestamp: " + err.Error())
+		return
+	}
+
+	end, err := parseTimestamp(endTimestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid end timestamp: " + err.Error())
+		return
+	}
+
+	if end.Before(start) {
+		resp.Error = function.NewFuncError("Invalid range: end timestamp is before start timestamp")
+		return
+	}
+
+	maxCount := defaultTZTransitionsMaxCount
+	if len(maxCounts) == 1 {
+		if maxCount, err = strconv.Atoi(maxCounts[0]); err != nil || maxCount < 1 {
+			resp.Error = function.NewFuncError("Invalid max_count: " + strconv.Quote(maxCounts[0]) + " is not a positive integer")
+			return
+		}
+	}
+
+	found := zoneTransitions(loc, start, end, maxCount+1)
+	if len(found) > maxCount {
+		resp.Error = function.NewFuncError("Too many transitions: the range has more than " + strconv.Itoa(maxCount) + " transitions, pass a larger max_count if this is intended")
+		return
+	}
+
+	transitions := []map[string]string{}
+	for _, tr := range found {
+		transitions = append(transitions, map[string]string{
+			"timestamp":      formatTimestamp(tr.at.UTC()),
+			"local_time":     formatTimestamp(tr.at),
+			"offset":         formatOffset(tr.offset),
+			"offset_seconds": strconv.Itoa(tr.offset),
+			"abbreviation":   tr.abbreviation,
+			"is_dst":         strconv.FormatBool(tr.isDST),
+		})
+	}
+
+	result, err := json.Marshal(transitions)
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to encode transitions: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(string(result)))
+}
@@ -0,0 +1,188 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTZTransitionsFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		zone      string
+		start     string
+		end       string
+		maxCount  []string
+		expected  []map[string]string
+		expectErr bool
+	}{
+		{
+			name:  "one year in London",
+			zone:  "Europe/London",
+			start: "2024-01-01T00:00:00Z",
+			end:   "2024-12-31T23:59:59Z",
+			expected: []map[string]string{
+				{
+					"timestamp":      "2024-03-31T01:00:00Z",
+					"local_time":     "2024-03-31T02:00:00+01:00",
+					"offset":         "+01:00",
+					"offset_seconds": "3600",
+					"abbreviation":   "BST",
+					"is_dst":         "true",
+				},
+				{
+					"timestamp":      "2024-10-27T01:00:00Z",
+					"local_time":     "2024-10-27T01:00:00Z",
+					"offset":         "+00:00",
+					"offset_seconds": "0",
+					"abbreviation":   "GMT",
+					"is_dst":         "false",
+				},
+			},
+		},
+		{
+			name:  "end is inclusive",
+			zone:  "Europe/London",
+			start: "2024-01-01T00:00:00Z",
+			end:   "2024-03-31T01:00:00Z",
+			expected: []map[string]string{
+				{
+					"timestamp":      "2024-03-31T01:00:00Z",
+					"local_time":     "2024-03-31T02:00:00+01:00",
+					"offset":         "+01:00",
+					"offset_seconds": "3600",
+					"abbreviation":   "BST",
+					"is_dst":         "true",
+				},
+			},
+		},
+		{
+			name:  "after the last explicit transition",
+			zone:  "America/New_York",
+			start: "2040-06-01T00:00:00Z",
+			end:   "2041-06-01T00:00:00Z",
+			expected: []map[string]string{
+				{
+					"timestamp":    "2040-11-04T06:00:00Z",
+					"local_time":   "2040-11-04T01:00:00-05:00",
+					"abbreviation": "EST",
+					"is_dst":       "false",
+				},
+				{
+					"timestamp":    "2041-03-10T07:00:00Z",
+					"local_time":   "2041-03-10T03:00:00-04:00",
+					"abbreviation": "EDT",
+					"is_dst":       "true",
+				},
+			},
+		},
+		{
+			name:     "max_count",
+			zone:     "Europe/London",
+			start:    "2024-01-01T00:00:00Z",
+			end:      "2024-12-31T23:59:59Z",
+			maxCount: []string{"2"},
+			expected: []map[string]string{
+				{"timestamp": "2024-03-31T01:00:00Z"},
+				{"timestamp": "2024-10-27T01:00:00Z"},
+			},
+		},
+		{
+			name:      "more than max_count",
+			zone:      "Europe/London",
+			start:     "2024-01-01T00:00:00Z",
+			end:       "2034-01-01T00:00:00Z",
+			maxCount:  []string{"10"},
+			expectErr: true,
+		},
+		{
+			name:      "more than the default max_count",
+			zone:      "America/New_York",
+			start:     "2024-01-01T00:00:00Z",
+			end:       "9999-01-01T00:00:00Z",
+			expectErr: true,
+		},
+		{
+			name:      "invalid max_count",
+			zone:      "UTC",
+			start:     "2024-01-01T00:00:00Z",
+			end:       "2025-01-01T00:00:00Z",
+			maxCount:  []string{"0"},
+			expectErr: true,
+		},
+		{
+			name:     "zone without transitions",
+			zone:     "UTC",
+			start:    "2024-01-01T00:00:00Z",
+			end:      "2025-01-01T00:00:00Z",
+			expected: []map[string]string{},
+		},
+		{
+			name:      "end before start",
+			zone:      "UTC",
+			start:     "2025-01-01T00:00:00Z",
+			end:       "2024-01-01T00:00:00Z",
+			expectErr: true,
+		},
+		{
+			name:      "unknown zone",
+			zone:      "Europe/Atlantis",
+			start:     "2024-01-01T00:00:00Z",
+			end:       "2025-01-01T00:00:00Z",
+			expectErr: true,
+		},
+		{
+			name:      "invalid start",
+			zone:      "UTC",
+			start:     "invalid",
+			end:       "2025-01-01T00:00:00Z",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewTZTransitionsFunction(),
+				types.StringValue(tc.zone), types.StringValue(tc.start), types.StringValue(tc.end), variadicStrings(tc.maxCount...))
+
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for zone %q, but got none", tc.zone)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for zone %q: %v", tc.zone, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			var actual []map[string]string
+			if err := json.Unmarshal([]byte(got.ValueString()), &actual); err != nil {
+				t.Fatalf("Failed to parse result JSON: %v", err)
+			}
+
+			if len(actual) != len(tc.expected) {
+				t.Fatalf("Expected %d transitions, got %d: %s", len(tc.expected), len(actual), got.ValueString())
+			}
+
+			for i, expected := range tc.expected {
+				for key, value := range expected {
+					if actual[i][key] != value {
+						t.Errorf("Transition %d: expected %s=%q, got %q", i, key, value, actual[i][key])
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &UTCToGPSFunction{}
+
+type UTCToGPSFunction struct{}
+
+func NewUTCToGPSFunction() function.Function {
+	return &UTCToGPSFunction{}
+}
+
+func (f *UTCToGPSFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "utc_to_gps"
+}
+
+func (f *UTCToGPSFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert UTC timestamp to GPS time",
+		Description: "Converts an RFC3339 UTC timestamp into GPS time, which has not counted leap seconds since 1980-01-06, returning the GPS clock reading formatted as RFC3339. Inserted leap seconds such as '2016-12-31T23:59:60Z' are accepted.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "RFC3339 formatted UTC timestamp string",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "leap_seconds_list",
+			Description: "Optional contents of an IERS leap-seconds.list file to use instead of the built-in leap second table",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *UTCToGPSFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+	var leapSecondsList []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &leapSecondsList))
+	if resp.Error != nil {
+		return
+	}
+
+	table, err := leapSecondTableFromArgs(leapSecondsList)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid leap-seconds.list: " + err.Error())
+		return
+	}
+
+	t, leap, err := parseUTCTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid RFC3339 timestamp: " + err.Error())
+		return
+	}
+
+	converted, err := table.utcToGPS(t, leap)
+	if err != nil {
+		resp.Error = function.NewFuncError("Cannot convert to GPS time: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(formatTimestamp(converted.UTC())))
+}
@@ -0,0 +1,146 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testLeapSecondsList is a leap-seconds.list that stops at the 2015 leap
+// second, so 2017 and later report a TAI-UTC offset of 36.
+const testLeapSecondsList = `#	Updated through IERS Bulletin C 50
+#$	3676924800
+#@	3692217600
+#
+2272060800	10	# 1 Jan 1972
+3550089600	35	# 1 Jul 2012
+3644697600	36	# 1 Jul 2015
+`
+
+func TestUTCToTAIFunction(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		overrides []string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "current offset",
+			input:    "2024-01-18T18:00:00Z",
+			expected: "2024-01-18T18:00:37Z",
+		},
+		{
+			name:     "second before leap second",
+			input:    "2016-12-31T23:59:59Z",
+			expected: "2017-01-01T00:00:35Z",
+		},
+		{
+			name:     "inserted leap second",
+			input:    "2016-12-31T23:59:60Z",
+			expected: "2017-01-01T00:00:36Z",
+		},
+		{
+			name:     "fractional leap second",
+			input:    "2016-12-31T23:59:60.25Z",
+			expected: "2017-01-01T00:00:36.25Z",
+		},
+		{
+			name:     "after leap second",
+			input:    "2017-01-01T00:00:00Z",
+			expected: "2017-01-01T00:00:37Z",
+		},
+		{
+			name:     "first table entry",
+			input:    "1972-01-01T00:00:00Z",
+			expected: "1972-01-01T00:00:10Z",
+		},
+		{
+			name:     "offset timestamps normalized",
+			input:    "2024-01-18T13:00:00-05:00",
+			expected: "2024-01-18T18:00:37Z",
+		},
+		{
+			name:      "override table",
+			input:     "2024-01-18T18:00:00Z",
+			overrides: []string{testLeapSecondsList},
+			expected:  "2024-01-18T18:00:36Z",
+		},
+		{
+			name:      "not a leap second",
+			input:     "2024-01-18T23:59:60Z",
+			expectErr: true,
+		},
+		{
+			name:      "before table",
+			input:     "1971-12-31T23:59:59Z",
+			expectErr: true,
+		},
+		{
+			name:      "invalid override",
+			input:     "2024-01-18T18:00:00Z",
+			overrides: []string{"not a table"},
+			expectErr: true,
+		},
+		{
+			name:      "override entry too far ahead for a duration",
+			input:     "2200-01-01T00:00:00Z",
+			overrides: []string{"2272060800 10\n99999999999 40\n"},
+			expected:  "2200-01-01T00:00:10Z",
+		},
+		{
+			name:      "override beyond year 9999",
+			input:     "2024-01-18T18:00:00Z",
+			overrides: []string{"2272060800 10\n300000000000 40\n"},
+			expectErr: true,
+		},
+		{
+			name:      "override before 1972",
+			input:     "2024-01-18T18:00:00Z",
+			overrides: []string{"2208988800 10\n"},
+			expectErr: true,
+		},
+		{
+			name:      "override going backwards",
+			input:     "2024-01-18T18:00:00Z",
+			overrides: []string{"3644697600 36\n3550089600 35\n"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid timestamp",
+			input:     "invalid",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewUTCToTAIFunction(), types.StringValue(tc.input), variadicStrings(tc.overrides...))
+
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
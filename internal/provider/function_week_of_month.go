@@ -0,0 +1,75 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &WeekOfMonthFunction{}
+
+type WeekOfMonthFunction struct{}
+
+func NewWeekOfMonthFunction() function.Function {
+	return &WeekOfMonthFunction{}
+}
+
+func (f *WeekOfMonthFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "week_of_month"
+}
+
+func (f *WeekOfMonthFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Get the week of the month of a timestamp",
+		Description: "Returns the week of the month (1-6) of an RFC3339 timestamp as an integer string, using the timestamp's own offset. " +
+			"Week 1 contains the first of the month and each later week begins on the optional week start day, defaulting to monday.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "RFC3339 formatted timestamp",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "week_start",
+			Description: "Optional day weeks start on (e.g., 'sunday')",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *WeekOfMonthFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+	var weekStarts []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &weekStarts))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid timestamp: " + err.Error())
+		return
+	}
+
+	weekStart := time.Monday
+	switch len(weekStarts) {
+	case 0:
+	case 1:
+		if weekStart, err = parseWeekday(weekStarts[0]); err != nil {
+			resp.Error = function.NewFuncError("Invalid week start: " + err.Error())
+			return
+		}
+	default:
+		resp.Error = function.NewFuncError("Invalid week start: at most one week start day may be supplied")
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(strconv.Itoa(weekOfMonth(t, weekStart))))
+}
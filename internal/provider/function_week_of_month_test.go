@@ -0,0 +1,90 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWeekOfMonthFunction(t *testing.T) {
+	testCases := []struct {
+		name       string
+		input      string
+		weekStarts []string
+		expected   string
+		expectErr  string
+	}{
+		{
+			name:     "first of the month",
+			input:    "2024-09-01T12:00:00Z",
+			expected: "1",
+		},
+		{
+			name:     "first monday starts week 2",
+			input:    "2024-09-02T12:00:00Z",
+			expected: "2",
+		},
+		{
+			name:       "sunday week start",
+			input:      "2024-09-02T12:00:00Z",
+			weekStarts: []string{"Sunday"},
+			expected:   "1",
+		},
+		{
+			name:     "sixth week",
+			input:    "2024-09-30T12:00:00Z",
+			expected: "6",
+		},
+		{
+			name:     "uses the timestamp offset",
+			input:    "2024-09-01T23:00:00-05:00",
+			expected: "1",
+		},
+		{
+			name:       "unknown week start",
+			input:      "2024-09-02T12:00:00Z",
+			weekStarts: []string{"funday"},
+			expectErr:  "unknown weekday",
+		},
+		{
+			name:       "too many week starts",
+			input:      "2024-09-02T12:00:00Z",
+			weekStarts: []string{"monday", "sunday"},
+			expectErr:  "at most one week start day",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewWeekOfMonthFunction(), types.StringValue(tc.input), variadicStrings(tc.weekStarts...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
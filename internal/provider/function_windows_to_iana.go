@@ -0,0 +1,70 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &WindowsToIANAFunction{}
+
+type WindowsToIANAFunction struct{}
+
+func NewWindowsToIANAFunction() function.Function {
+	return &WindowsToIANAFunction{}
+}
+
+func (f *WindowsToIANAFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "windows_to_iana"
+}
+
+func (f *WindowsToIANAFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a Windows time zone ID to an IANA time zone",
+		Description: "Returns the canonical IANA time zone name for a Windows time zone ID (e.g., 'W. Europe Standard Time') using the embedded CLDR windowsZones mapping. " +
+			"An optional ISO 3166 territory code selects the zone CLDR lists for that territory, falling back to the default mapping when it has none.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "Windows time zone ID (e.g., 'Eastern Standard Time')",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "territory",
+			Description: "Optional ISO 3166 territory code (e.g., 'CA')",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *WindowsToIANAFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	var territories []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name, &territories))
+	if resp.Error != nil {
+		return
+	}
+
+	territory := windowsDefaultTerritory
+	switch len(territories) {
+	case 0:
+	case 1:
+		territory = territories[0]
+	default:
+		resp.Error = function.NewFuncError("Invalid territory: at most one territory may be supplied")
+		return
+	}
+
+	zone, err := windowsToIANA(name, territory)
+	if err != nil {
+		resp.Error = function.NewFuncError("Invalid Windows time zone: " + err.Error())
+		return
+	}
+
+	resp.Result = function.NewResultData(types.StringValue(zone))
+}
@@ -0,0 +1,101 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWindowsToIANAFunction(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       string
+		territories []string
+		expected    string
+		expectErr   string
+	}{
+		{
+			name:     "default territory",
+			input:    "W. Europe Standard Time",
+			expected: "Europe/Berlin",
+		},
+		{
+			name:        "specific territory",
+			input:       "W. Europe Standard Time",
+			territories: []string{"CH"},
+			expected:    "Europe/Zurich",
+		},
+		{
+			name:        "lower case territory",
+			input:       "Eastern Standard Time",
+			territories: []string{"ca"},
+			expected:    "America/Toronto",
+		},
+		{
+			name:        "territory without mapping falls back to default",
+			input:       "Eastern Standard Time",
+			territories: []string{"FR"},
+			expected:    "America/New_York",
+		},
+		{
+			name:     "legacy CLDR names are canonicalized",
+			input:    "India Standard Time",
+			expected: "Asia/Kolkata",
+		},
+		{
+			name:     "case insensitive",
+			input:    "pacific standard time",
+			expected: "America/Los_Angeles",
+		},
+		{
+			name:     "UTC",
+			input:    "UTC",
+			expected: "Etc/UTC",
+		},
+		{
+			name:      "unknown name suggests close matches",
+			input:     "W. Europe Standart Time",
+			expectErr: `did you mean "W. Europe Standard Time"`,
+		},
+		{
+			name:        "too many territories",
+			input:       "UTC",
+			territories: []string{"US", "CA"},
+			expectErr:   "at most one territory",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := runFunction(t, NewWindowsToIANAFunction(), types.StringValue(tc.input), variadicStrings(tc.territories...))
+
+			if tc.expectErr != "" {
+				if err == nil {
+					t.Errorf("Expected error for input %q, but got none", tc.input)
+				} else if !strings.Contains(err.Error(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Unexpected error for input %q: %v", tc.input, err)
+				return
+			}
+
+			got, ok := result.(types.String)
+			if !ok {
+				t.Errorf("Expected types.String, got %T", result)
+				return
+			}
+
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// runFunction calls f with the given argument values and returns the result
+// value, failing the test if the function returned no result and no error.
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData(args),
+	}
+	resp := &function.RunResponse{}
+
+	f.Run(context.Background(), req, resp)
+
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+
+	if resp.Result == (function.ResultData{}) {
+		t.Fatalf("Expected result, got empty ResultData")
+	}
+
+	return resp.Result.Value(), nil
+}
+
+// variadicStrings builds the tuple Terraform passes for a variadic string
+// parameter.
+func variadicStrings(values ...string) attr.Value {
+	elemTypes := make([]attr.Type, len(values))
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elemTypes[i] = types.StringType
+		elems[i] = types.StringValue(v)
+	}
+
+	return types.TupleValueMust(elemTypes, elems)
+}
+
+// readDataSource calls d's Read with a configuration built from the given
+// attribute values, leaving every other attribute null, and returns the
+// resulting state and diagnostics.
+func readDataSource(t *testing.T, d datasource.DataSource, config map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatalf("Expected schema to be an object type")
+	}
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := config[name]; ok {
+			values[name] = v
+		} else {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+
+	d.Read(ctx, req, resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+// resourceSchema returns r's schema and its object type.
+func resourceSchema(t *testing.T, r resource.Resource) (resource.SchemaResponse, tftypes.Object) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatalf("Expected schema to be an object type")
+	}
+
+	return *schemaResp, objectType
+}
+
+// resourceConfig builds a configuration or plan for r from the given
+// attribute values. Other attributes are null, or unknown when unknown is
+// set and they are computed, as in a plan.
+func resourceConfig(t *testing.T, r resource.Resource, config map[string]tftypes.Value, unknown bool) (resource.SchemaResponse, tftypes.Value) {
+	t.Helper()
+
+	schemaResp, objectType := resourceSchema(t, r)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		switch v, ok := config[name]; {
+		case ok:
+			values[name] = v
+		case unknown && schemaResp.Schema.Attributes[name].IsComputed():
+			values[name] = tftypes.NewValue(attrType, tftypes.UnknownValue)
+		default:
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	return schemaResp, tftypes.NewValue(objectType, values)
+}
+
+// validateResource calls r's ValidateConfig with a configuration built from
+// the given attribute values and returns the diagnostics.
+func validateResource(t *testing.T, r resource.ResourceWithValidateConfig, config map[string]tftypes.Value) diag.Diagnostics {
+	t.Helper()
+
+	schemaResp, raw := resourceConfig(t, r, config, false)
+
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+	}, resp)
+
+	return resp.Diagnostics
+}
+
+// createResource calls r's Create with a plan built from the given attribute
+// values, leaving other computed attributes unknown, and returns the
+// resulting state and diagnostics.
+func createResource(t *testing.T, r resource.Resource, config map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	schemaResp, raw := resourceConfig(t, r, config, true)
+	_, nullConfig := resourceConfig(t, r, config, false)
+	objectType, _ := raw.Type().(tftypes.Object)
+
+	req := resource.CreateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: nullConfig},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw},
+	}
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+
+	r.Create(context.Background(), req, resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+// readResource calls r's Read with state and returns the refreshed state and
+// diagnostics.
+func readResource(t *testing.T, r resource.Resource, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	resp := &resource.ReadResponse{
+		State: tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()},
+	}
+
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+// stateString returns the string attribute name from state.
+func stateString(t *testing.T, state tfsdk.State, name string) string {
+	t.Helper()
+
+	var value types.String
+	if diags := state.GetAttribute(context.Background(), path.Root(name), &value); diags.HasError() {
+		t.Fatalf("Failed to read %s from state: %v", name, diags)
+	}
+
+	return value.ValueString()
+}
+
+// intervalValue builds an interval object argument.
+func intervalValue(start, end string) types.Object {
+	return types.ObjectValueMust(intervalAttributeTypes, map[string]attr.Value{
+		"start": types.StringValue(start),
+		"end":   types.StringValue(end),
+	})
+}
+
+// intervalList builds a list argument of intervals written as "start/end".
+func intervalList(intervals ...string) types.List {
+	elems := make([]attr.Value, len(intervals))
+	for i, iv := range intervals {
+		start, end, _ := strings.Cut(iv, "/")
+		elems[i] = intervalValue(start, end)
+	}
+
+	return types.ListValueMust(intervalObjectType, elems)
+}
+
+// intervalListStrings renders a list of interval results as "start/end"
+// strings.
+func intervalListStrings(t *testing.T, value attr.Value) []string {
+	t.Helper()
+
+	list, ok := value.(types.List)
+	if !ok {
+		t.Fatalf("Expected types.List, got %T", value)
+	}
+
+	var intervals []string
+	for _, elem := range list.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok {
+			t.Fatalf("Expected types.Object element, got %T", elem)
+		}
+
+		start, _ := obj.Attributes()["start"].(types.String)
+		end, _ := obj.Attributes()["end"].(types.String)
+		intervals = append(intervals, start.ValueString()+"/"+end.ValueString())
+	}
+
+	return intervals
+}
+
+// modifyResourcePlan calls r's ModifyPlan with the prior state and a
+// proposed plan and returns the modified plan and diagnostics.
+func modifyResourcePlan(t *testing.T, r resource.ResourceWithModifyPlan, state tfsdk.State, plan tftypes.Value) (tfsdk.Plan, diag.Diagnostics) {
+	t.Helper()
+
+	req := resource.ModifyPlanRequest{
+		State: state,
+		Plan:  tfsdk.Plan{Schema: state.Schema, Raw: plan},
+	}
+	resp := &resource.ModifyPlanResponse{
+		Plan: tfsdk.Plan{Schema: state.Schema, Raw: plan.Copy()},
+	}
+
+	r.ModifyPlan(context.Background(), req, resp)
+
+	return resp.Plan, resp.Diagnostics
+}
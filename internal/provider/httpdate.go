@@ -0,0 +1,79 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// imfFixdateLayout is the preferred HTTP-date format of RFC 7231 section
+// 7.1.1.1, as sent in Date and Last-Modified headers.
+const imfFixdateLayout = "Mon, 02 Jan 2006 15:04:05 GMT"
+
+// asctimeLayout is the obsolete ANSI C asctime() HTTP-date format, with the
+// day of the month padded with a space.
+const asctimeLayout = "Mon Jan _2 15:04:05 2006"
+
+// rfc850DatePattern matches the obsolete RFC 850 HTTP-date format, which
+// spells out the day name and has a two-digit year.
+var rfc850DatePattern = regexp.MustCompile(`^(Monday|Tuesday|Wednesday|Thursday|Friday|Saturday|Sunday), (\d{2}-[A-Z][a-z]{2}-)(\d{2})( \d{2}:\d{2}:\d{2} GMT)$`)
+
+// parseHTTPDate parses an HTTP-date in the IMF-fixdate format or either of
+// the obsolete RFC 850 and asctime formats, all of which are in UTC. The day
+// name must match the date. RFC 850 two-digit years that would be more than
+// 50 years after reference are in the previous century, as RFC 7231
+// requires; without a reference, 00 to 49 are 2000 to 2049 and 50 to 99 are
+// 1950 to 1999.
+func parseHTTPDate(value string, reference *time.Time) (time.Time, error) {
+	t, day, err := parseHTTPDateFields(value, reference)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not an HTTP-date in IMF-fixdate (%s), RFC 850 or asctime form", value, imfFixdateLayout)
+	}
+
+	if name := t.Weekday().String(); name != day && name[:3] != day {
+		return time.Time{}, fmt.Errorf("%q: %s is a %s", value, t.Format(time.DateOnly), name)
+	}
+
+	return t, nil
+}
+
+// parseHTTPDateFields parses value in whichever HTTP-date format matches,
+// also returning the day name it was written with.
+func parseHTTPDateFields(value string, reference *time.Time) (time.Time, string, error) {
+	if t, err := time.Parse(imfFixdateLayout, value); err == nil {
+		return t, value[:3], nil
+	}
+
+	if t, err := time.Parse(asctimeLayout, value); err == nil {
+		return t, value[:3], nil
+	}
+
+	m := rfc850DatePattern.FindStringSubmatch(value)
+	if m == nil {
+		return time.Time{}, "", fmt.Errorf("%q is not an HTTP-date", value)
+	}
+
+	yy, _ := strconv.Atoi(m[3])
+	year := 1900 + yy
+	if reference == nil {
+		if yy < 50 {
+			year += 100
+		}
+	} else {
+		// The latest year ending in yy that is at most 50 years after the
+		// reference.
+		limit := reference.UTC().Year() + 50
+		year = limit - ((limit-yy)%100+100)%100
+	}
+
+	t, err := time.Parse("Monday, 02-Jan-2006 15:04:05 GMT", m[1]+", "+m[2]+fmt.Sprintf("%04d", year)+m[4])
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	return t, m[1], nil
+}
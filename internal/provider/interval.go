@@ -0,0 +1,206 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// intervalAttributeTypes are the attributes of the {start, end} objects the
+// interval functions accept and return.
+var intervalAttributeTypes = map[string]attr.Type{
+	"start": types.StringType,
+	"end":   types.StringType,
+}
+
+// intervalObjectType is the Terraform type of an interval.
+var intervalObjectType = types.ObjectType{AttrTypes: intervalAttributeTypes}
+
+// intervalModel is an interval as written in configuration.
+type intervalModel struct {
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+// intervalModelFromObject reads the start and end attributes of an object
+// passed through a dynamic parameter, leaving missing or non-string ones null.
+func intervalModelFromObject(obj types.Object) intervalModel {
+	m := intervalModel{Start: types.StringNull(), End: types.StringNull()}
+
+	if start, ok := obj.Attributes()["start"].(types.String); ok {
+		m.Start = start
+	}
+	if end, ok := obj.Attributes()["end"].(types.String); ok {
+		m.End = end
+	}
+
+	return m
+}
+
+// interval is the half-open range of instants [start, end): it includes
+// start but not end, so intervals that meet end to end do not overlap. An
+// interval whose start equals its end is empty.
+type interval struct {
+	start time.Time
+	end   time.Time
+}
+
+// parseInterval parses the RFC3339 endpoints of an interval, which must not
+// end before it starts.
+func parseInterval(m intervalModel) (interval, error) {
+	if m.Start.IsNull() || m.End.IsNull() {
+		return interval{}, errors.New("start and end must both be set")
+	}
+
+	start, err := parseTimestamp(m.Start.ValueString())
+	if err != nil {
+		return interval{}, fmt.Errorf("invalid start: %w", err)
+	}
+
+	end, err := parseTimestamp(m.End.ValueString())
+	if err != nil {
+		return interval{}, fmt.Errorf("invalid end: %w", err)
+	}
+
+	if end.Before(start) {
+		return interval{}, fmt.Errorf("end %s is before start %s", m.End.ValueString(), m.Start.ValueString())
+	}
+
+	return interval{start: start, end: end}, nil
+}
+
+// parseIntervals parses a list of intervals, identifying any invalid one by
+// its index.
+func parseIntervals(models []intervalModel) ([]interval, error) {
+	intervals := make([]interval, 0, len(models))
+	for i, m := range models {
+		iv, err := parseInterval(m)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		intervals = append(intervals, iv)
+	}
+
+	return intervals, nil
+}
+
+func (iv interval) isEmpty() bool {
+	return !iv.start.Before(iv.end)
+}
+
+// overlaps reports whether iv and o share at least one instant.
+func (iv interval) overlaps(o interval) bool {
+	return !iv.isEmpty() && !o.isEmpty() && iv.start.Before(o.end) && o.start.Before(iv.end)
+}
+
+// contains reports whether t is in iv.
+func (iv interval) contains(t time.Time) bool {
+	return !t.Before(iv.start) && t.Before(iv.end)
+}
+
+// containsInterval reports whether every instant of o is in iv.
+func (iv interval) containsInterval(o interval) bool {
+	return !o.start.Before(iv.start) && !o.end.After(iv.end)
+}
+
+// intersection returns the instants in both iv and o, or false if there are
+// none.
+func (iv interval) intersection(o interval) (interval, bool) {
+	if !iv.overlaps(o) {
+		return interval{}, false
+	}
+
+	return interval{start: maxTime(iv.start, o.start), end: minTime(iv.end, o.end)}, true
+}
+
+// value returns iv as a Terraform object, keeping the offsets of the
+// timestamps it was parsed from.
+func (iv interval) value() types.Object {
+	return types.ObjectValueMust(intervalAttributeTypes, map[string]attr.Value{
+		"start": types.StringValue(formatTimestamp(iv.start)),
+		"end":   types.StringValue(formatTimestamp(iv.end)),
+	})
+}
+
+// intervalListValue returns intervals as a Terraform list of objects.
+func intervalListValue(intervals []interval) types.List {
+	elems := make([]attr.Value, 0, len(intervals))
+	for _, iv := range intervals {
+		elems = append(elems, iv.value())
+	}
+
+	return types.ListValueMust(intervalObjectType, elems)
+}
+
+// mergeIntervals returns the union of intervals as a sorted list of
+// non-empty intervals, joining those that overlap or meet end to end.
+func mergeIntervals(intervals []interval) []interval {
+	sorted := slices.Clone(intervals)
+	slices.SortStableFunc(sorted, func(a, b interval) int {
+		return a.start.Compare(b.start)
+	})
+
+	var merged []interval
+	for _, iv := range sorted {
+		if iv.isEmpty() {
+			continue
+		}
+
+		if n := len(merged); n > 0 && !iv.start.After(merged[n-1].end) {
+			merged[n-1].end = maxTime(merged[n-1].end, iv.end)
+			continue
+		}
+
+		merged = append(merged, iv)
+	}
+
+	return merged
+}
+
+// subtractIntervals returns the instants in from that are not in remove, as
+// a sorted list of non-empty intervals.
+func subtractIntervals(from, remove []interval) []interval {
+	removed := mergeIntervals(remove)
+
+	var result []interval
+	for _, iv := range mergeIntervals(from) {
+		for _, r := range removed {
+			if !r.overlaps(iv) {
+				continue
+			}
+
+			if iv.start.Before(r.start) {
+				result = append(result, interval{start: iv.start, end: r.start})
+			}
+			iv.start = maxTime(iv.start, r.end)
+		}
+
+		if !iv.isEmpty() {
+			result = append(result, iv)
+		}
+	}
+
+	return result
+}
+
+// intervalGaps returns the gaps between intervals, from the start of the
+// first to the end of the last, or across bounds when given.
+func intervalGaps(intervals []interval, bounds *interval) []interval {
+	merged := mergeIntervals(intervals)
+
+	if bounds == nil {
+		if len(merged) == 0 {
+			return nil
+		}
+		bounds = &interval{start: merged[0].start, end: merged[len(merged)-1].end}
+	}
+
+	return subtractIntervals([]interval{*bounds}, merged)
+}
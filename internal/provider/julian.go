@@ -0,0 +1,132 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// julianDayUnixEpoch is the Julian Day of 1970-01-01T00:00:00Z.
+	julianDayUnixEpoch = "2440587.5"
+	// mjdUnixEpoch is the Modified Julian Date of 1970-01-01T00:00:00Z.
+	mjdUnixEpoch = "40587"
+
+	// fractionalDayPrecision is the number of decimal places emitted for
+	// fractional day values, which resolves to roughly 86 microseconds.
+	fractionalDayPrecision = 9
+
+	nanosPerDay = int64(24 * time.Hour)
+)
+
+var (
+	decimalPattern     = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+	ordinalDatePattern = regexp.MustCompile(`^([0-9]{4})-?([0-9]{3})(\.[0-9]+)?$`)
+)
+
+// daysSinceEpoch returns the exact number of days between epoch (expressed
+// as a day number on the same scale) and t.
+func daysSinceEpoch(t time.Time, epoch string) *big.Rat {
+	nanos := new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(int64(time.Second)))
+	nanos.Add(nanos, big.NewInt(int64(t.Nanosecond())))
+
+	days := new(big.Rat).SetFrac(nanos, big.NewInt(nanosPerDay))
+	offset, _ := new(big.Rat).SetString(epoch)
+
+	return days.Add(days, offset)
+}
+
+// timeFromDays converts a day number on the scale described by epoch back to
+// a UTC time, rounded to the nearest millisecond.
+func timeFromDays(days *big.Rat, epoch string) (time.Time, error) {
+	offset, _ := new(big.Rat).SetString(epoch)
+	elapsed := new(big.Rat).Sub(days, offset)
+
+	millis := new(big.Rat).Mul(elapsed, big.NewRat(nanosPerDay/int64(time.Millisecond), 1))
+	rounded := roundRat(millis)
+	if !rounded.IsInt64() {
+		return time.Time{}, errors.New("value is out of range")
+	}
+
+	t := time.UnixMilli(rounded.Int64()).UTC()
+	if t.Year() < 0 || t.Year() > 9999 {
+		return time.Time{}, errors.New("value is out of range")
+	}
+
+	return t, nil
+}
+
+// roundRat rounds r to the nearest integer, with halves rounded away from zero.
+func roundRat(r *big.Rat) *big.Int {
+	num := new(big.Int).Set(r.Num())
+	den := r.Denom()
+
+	half := new(big.Int).Quo(den, big.NewInt(2))
+	if num.Sign() < 0 {
+		num.Sub(num, half)
+	} else {
+		num.Add(num, half)
+	}
+
+	return num.Quo(num, den)
+}
+
+// formatFractionalDays renders days with up to fractionalDayPrecision decimal
+// places, trimming any trailing zeros.
+func formatFractionalDays(days *big.Rat) string {
+	s := days.FloatString(fractionalDayPrecision)
+	s = strings.TrimRight(s, "0")
+
+	return strings.TrimSuffix(s, ".")
+}
+
+// parseFractionalDays parses a decimal day number such as "2460325.4375".
+func parseFractionalDays(value string) (*big.Rat, error) {
+	if !decimalPattern.MatchString(value) {
+		return nil, fmt.Errorf("%q is not a decimal number", value)
+	}
+
+	days, _ := new(big.Rat).SetString(value)
+
+	return days, nil
+}
+
+// formatOrdinalDate renders the calendar date of t, in its own offset, as an
+// ISO 8601 ordinal date (YYYY-DDD).
+func formatOrdinalDate(t time.Time) string {
+	return fmt.Sprintf("%04d-%03d", t.Year(), t.YearDay())
+}
+
+// parseOrdinalDate parses an ISO 8601 ordinal date in extended (YYYY-DDD) or
+// basic (YYYYDDD) form, with an optional decimal fraction of the day, and
+// returns the corresponding UTC time rounded to the nearest millisecond.
+func parseOrdinalDate(value string) (time.Time, error) {
+	m := ordinalDatePattern.FindStringSubmatch(value)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("%q is not an ordinal date in YYYY-DDD form", value)
+	}
+
+	year, _ := strconv.Atoi(m[1])
+	day, _ := strconv.Atoi(m[2])
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if day < 1 || day > start.AddDate(1, 0, -1).YearDay() {
+		return time.Time{}, fmt.Errorf("day %d does not exist in %d", day, year)
+	}
+
+	t := start.AddDate(0, 0, day-1)
+	if m[3] != "" {
+		fraction, _ := new(big.Rat).SetString("0" + m[3])
+		millis := roundRat(fraction.Mul(fraction, big.NewRat(nanosPerDay/int64(time.Millisecond), 1)))
+		t = t.Add(time.Duration(millis.Int64()) * time.Millisecond)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,302 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// gpsTAIOffset is the constant difference in seconds between TAI and GPS
+// time, fixed when GPS time began at 1980-01-06T00:00:00Z.
+const gpsTAIOffset = 19
+
+// ntpEpoch is the origin of the timestamps used in leap-seconds.list.
+var ntpEpoch = time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// leapSecondsListStart and leapSecondsListEnd bound the entries accepted in
+// a leap-seconds.list: UTC has had whole leap seconds since 1972, and RFC3339
+// cannot represent years after 9999.
+var (
+	leapSecondsListStart = time.Date(1972, time.January, 1, 0, 0, 0, 0, time.UTC)
+	leapSecondsListEnd   = time.Date(10000, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// gpsEpoch is the instant GPS time began counting.
+var gpsEpoch = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+var leapSecondTimestampPattern = regexp.MustCompile(`^([0-9]{4}-[0-9]{2}-[0-9]{2}[Tt][0-9]{2}:[0-9]{2}:)60(.*)$`)
+
+// leapSecond records the TAI-UTC offset in effect from a UTC instant onwards.
+type leapSecond struct {
+	effective time.Time
+	taiOffset int64
+}
+
+// leapSecondTable is an ordered list of TAI-UTC offsets. Go's time package
+// ignores leap seconds, so every TAI and GPS conversion goes through one.
+type leapSecondTable []leapSecond
+
+// builtinLeapSeconds is the IERS leap second table, as published in the IANA
+// leap-seconds.list file.
+var builtinLeapSeconds = leapSecondTable{
+	{time.Date(1972, time.January, 1, 0, 0, 0, 0, time.UTC), 10},
+	{time.Date(1972, time.July, 1, 0, 0, 0, 0, time.UTC), 11},
+	{time.Date(1973, time.January, 1, 0, 0, 0, 0, time.UTC), 12},
+	{time.Date(1974, time.January, 1, 0, 0, 0, 0, time.UTC), 13},
+	{time.Date(1975, time.January, 1, 0, 0, 0, 0, time.UTC), 14},
+	{time.Date(1976, time.January, 1, 0, 0, 0, 0, time.UTC), 15},
+	{time.Date(1977, time.January, 1, 0, 0, 0, 0, time.UTC), 16},
+	{time.Date(1978, time.January, 1, 0, 0, 0, 0, time.UTC), 17},
+	{time.Date(1979, time.January, 1, 0, 0, 0, 0, time.UTC), 18},
+	{time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC), 19},
+	{time.Date(1981, time.July, 1, 0, 0, 0, 0, time.UTC), 20},
+	{time.Date(1982, time.July, 1, 0, 0, 0, 0, time.UTC), 21},
+	{time.Date(1983, time.July, 1, 0, 0, 0, 0, time.UTC), 22},
+	{time.Date(1985, time.July, 1, 0, 0, 0, 0, time.UTC), 23},
+	{time.Date(1988, time.January, 1, 0, 0, 0, 0, time.UTC), 24},
+	{time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), 25},
+	{time.Date(1991, time.January, 1, 0, 0, 0, 0, time.UTC), 26},
+	{time.Date(1992, time.July, 1, 0, 0, 0, 0, time.UTC), 27},
+	{time.Date(1993, time.July, 1, 0, 0, 0, 0, time.UTC), 28},
+	{time.Date(1994, time.July, 1, 0, 0, 0, 0, time.UTC), 29},
+	{time.Date(1996, time.January, 1, 0, 0, 0, 0, time.UTC), 30},
+	{time.Date(1997, time.July, 1, 0, 0, 0, 0, time.UTC), 31},
+	{time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC), 32},
+	{time.Date(2006, time.January, 1, 0, 0, 0, 0, time.UTC), 33},
+	{time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC), 34},
+	{time.Date(2012, time.July, 1, 0, 0, 0, 0, time.UTC), 35},
+	{time.Date(2015, time.July, 1, 0, 0, 0, 0, time.UTC), 36},
+	{time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC), 37},
+}
+
+// parseLeapSecondsList parses the contents of an IANA/IERS leap-seconds.list
+// file. Each data line holds an NTP timestamp and the TAI-UTC offset that
+// takes effect at that instant; comment lines starting with '#' are ignored.
+func parseLeapSecondsList(contents string) (leapSecondTable, error) {
+	var table leapSecondTable
+
+	scanner := bufio.NewScanner(strings.NewReader(contents))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected an NTP timestamp and a TAI-UTC offset", line)
+		}
+
+		ntpSeconds, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid NTP timestamp %q", line, fields[0])
+		}
+
+		offset, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid TAI-UTC offset %q", line, fields[1])
+		}
+
+		// Checked in NTP seconds, as converting first could overflow.
+		if ntpSeconds < leapSecondsListStart.Unix()-ntpEpoch.Unix() || ntpSeconds >= leapSecondsListEnd.Unix()-ntpEpoch.Unix() {
+			return nil, fmt.Errorf("line %d: NTP timestamp %d is outside the years 1972 to 9999", line, ntpSeconds)
+		}
+
+		effective := time.Unix(ntpSeconds+ntpEpoch.Unix(), 0).UTC()
+		if len(table) > 0 && !effective.After(table[len(table)-1].effective) {
+			return nil, fmt.Errorf("line %d: entries must be in increasing time order", line)
+		}
+
+		table = append(table, leapSecond{effective: effective, taiOffset: offset})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(table) == 0 {
+		return nil, errors.New("no leap second entries found")
+	}
+
+	return table, nil
+}
+
+// leapSecondTableFromArgs returns the table to use for a function call: the
+// built-in table, or one parsed from an optional leap-seconds.list argument.
+func leapSecondTableFromArgs(overrides []string) (leapSecondTable, error) {
+	switch len(overrides) {
+	case 0:
+		return builtinLeapSeconds, nil
+	case 1:
+		return parseLeapSecondsList(overrides[0])
+	default:
+		return nil, errors.New("at most one leap-seconds.list may be supplied")
+	}
+}
+
+// taiMinusUTC returns the TAI-UTC offset in seconds at the UTC instant t.
+func (lt leapSecondTable) taiMinusUTC(t time.Time) (int64, error) {
+	if t.Before(lt[0].effective) {
+		return 0, fmt.Errorf("leap second table starts at %s", formatTimestamp(lt[0].effective))
+	}
+
+	offset := lt[0].taiOffset
+	for _, ls := range lt[1:] {
+		if t.Before(ls.effective) {
+			break
+		}
+		offset = ls.taiOffset
+	}
+
+	return offset, nil
+}
+
+// utcToTAI converts a UTC instant into the reading of a TAI clock. When leap
+// is set, t is the 59th second of the minute and the instant is the inserted
+// leap second that follows it.
+func (lt leapSecondTable) utcToTAI(t time.Time, leap bool) (time.Time, error) {
+	offset, err := lt.taiMinusUTC(t)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if leap {
+		next := t.Truncate(time.Second).Add(time.Second)
+		inserted := false
+		for _, ls := range lt {
+			if ls.effective.Equal(next) && ls.taiOffset > offset {
+				inserted = true
+			}
+		}
+
+		if !inserted {
+			return time.Time{}, fmt.Errorf("no leap second was inserted before %s", formatTimestamp(next))
+		}
+
+		offset++
+	}
+
+	return t.Add(time.Duration(offset) * time.Second), nil
+}
+
+// taiToUTC converts a TAI clock reading into UTC. Readings that fall within
+// an inserted leap second return the 59th second of the minute with leap set.
+func (lt leapSecondTable) taiToUTC(tai time.Time) (time.Time, bool, error) {
+	if tai.Before(lt[0].effective.Add(time.Duration(lt[0].taiOffset) * time.Second)) {
+		return time.Time{}, false, fmt.Errorf("leap second table starts at %s", formatTimestamp(lt[0].effective))
+	}
+
+	index := 0
+	for i, ls := range lt {
+		if tai.Before(ls.effective.Add(time.Duration(ls.taiOffset) * time.Second)) {
+			break
+		}
+		index = i
+	}
+
+	utc := tai.Add(-time.Duration(lt[index].taiOffset) * time.Second)
+	if index+1 < len(lt) && !utc.Before(lt[index+1].effective) {
+		// Between the end of the last UTC second and the moment the new
+		// offset takes effect on the TAI scale: an inserted leap second.
+		within := utc.Sub(lt[index+1].effective)
+		return lt[index+1].effective.Add(-time.Second).Add(within), true, nil
+	}
+
+	return utc, false, nil
+}
+
+// utcToGPS converts a UTC instant into the reading of a GPS clock.
+func (lt leapSecondTable) utcToGPS(t time.Time, leap bool) (time.Time, error) {
+	if t.Before(gpsEpoch) {
+		return time.Time{}, fmt.Errorf("GPS time starts at %s", formatTimestamp(gpsEpoch))
+	}
+
+	tai, err := lt.utcToTAI(t, leap)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return tai.Add(-gpsTAIOffset * time.Second), nil
+}
+
+// gpsToUTC converts a GPS clock reading into UTC, reporting inserted leap
+// seconds as taiToUTC does.
+func (lt leapSecondTable) gpsToUTC(gps time.Time) (time.Time, bool, error) {
+	if gps.Before(gpsEpoch) {
+		return time.Time{}, false, fmt.Errorf("GPS time starts at %s", formatTimestamp(gpsEpoch))
+	}
+
+	return lt.taiToUTC(gps.Add(gpsTAIOffset * time.Second))
+}
+
+// gpsMinusUTC returns the number of seconds GPS time is ahead of UTC at the
+// UTC instant t. GPS time did not exist before 1980, so earlier instants
+// report no offset.
+func gpsMinusUTC(t time.Time) int64 {
+	offset, err := builtinLeapSeconds.taiMinusUTC(t)
+	if err != nil {
+		return 0
+	}
+
+	return max(offset-gpsTAIOffset, 0)
+}
+
+// utcFromGPS converts a GPS instant, expressed as if it were a UTC time
+// without leap seconds, back into UTC. Readings within an inserted leap
+// second collapse onto the following UTC second.
+func utcFromGPS(g time.Time) time.Time {
+	if g.Before(gpsEpoch) {
+		return g
+	}
+
+	utc, leap, err := builtinLeapSeconds.gpsToUTC(g)
+	if err != nil {
+		return g
+	}
+
+	if leap {
+		return utc.Truncate(time.Second).Add(time.Second)
+	}
+
+	return utc
+}
+
+// parseUTCTimestamp parses an RFC3339 timestamp that may name an inserted leap
+// second (e.g., 2016-12-31T23:59:60Z), which Go's time package rejects. Leap
+// seconds are returned as the preceding second with leap set.
+func parseUTCTimestamp(value string) (time.Time, bool, error) {
+	if m := leapSecondTimestampPattern.FindStringSubmatch(value); m != nil {
+		t, err := parseTimestamp(m[1] + "59" + m[2])
+		if err != nil {
+			return time.Time{}, false, err
+		}
+
+		return t, true, nil
+	}
+
+	t, err := parseTimestamp(value)
+
+	return t, false, err
+}
+
+// formatUTCTimestamp renders t as RFC3339, showing the seconds field as 60
+// when t stands for an inserted leap second.
+func formatUTCTimestamp(t time.Time, leap bool) string {
+	s := formatTimestamp(t.UTC())
+	if !leap {
+		return s
+	}
+
+	return s[:17] + "60" + s[19:]
+}
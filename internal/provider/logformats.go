@@ -0,0 +1,140 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Year inference policies choose the year of an RFC 3164 syslog timestamp,
+// which has none, from a reference time.
+const (
+	yearInferenceNearest = "nearest"
+	yearInferencePast    = "past"
+	yearInferenceCurrent = "current"
+)
+
+var yearInferencePolicies = []string{
+	yearInferenceNearest,
+	yearInferencePast,
+	yearInferenceCurrent,
+}
+
+// clfTimestampLayout is the %t timestamp of the Common Log Format written by
+// Apache and Nginx, without its surrounding brackets.
+const clfTimestampLayout = "02/Jan/2006:15:04:05 -0700"
+
+var (
+	// syslogPriorityPattern matches the <PRI> header of a syslog message and,
+	// for RFC 5424 messages, the version that follows it.
+	syslogPriorityPattern = regexp.MustCompile(`^<\d{1,3}>(?:[1-9]\d{0,2} )?`)
+
+	// rfc3164TimestampPattern matches an RFC 3164 "Mmm dd hh:mm:ss"
+	// timestamp, where the day may be padded with a space or a zero.
+	rfc3164TimestampPattern = regexp.MustCompile(`^(Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec) {1,2}(\d{1,2}) (\d{2}):(\d{2}):(\d{2})`)
+
+	// rfc5424TimestampPattern matches the RFC 5424 TIMESTAMP, an RFC 3339
+	// timestamp with an upper case T and Z and at most six fractional digits.
+	rfc5424TimestampPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d{1,6})?(Z|[+-]\d{2}:\d{2})$`)
+)
+
+// parseRFC3164Timestamp parses the timestamp of a BSD syslog message, either
+// alone or at the start of a whole message after its <PRI> header. The
+// timestamp is a wall clock time in loc and its year is chosen by policy
+// relative to reference: the year placing it nearest to reference, the
+// latest year placing it at or before reference, or reference's own year.
+func parseRFC3164Timestamp(value string, loc *time.Location, reference time.Time, policy string) (time.Time, error) {
+	if !slices.Contains(yearInferencePolicies, policy) {
+		return time.Time{}, fmt.Errorf("unknown year inference %q, expected one of: %s", policy, strings.Join(yearInferencePolicies, ", "))
+	}
+
+	m := rfc3164TimestampPattern.FindStringSubmatch(syslogPriorityPattern.ReplaceAllString(value, ""))
+	if m == nil {
+		return time.Time{}, fmt.Errorf("%q does not start with an RFC 3164 timestamp in Mmm dd hh:mm:ss form", value)
+	}
+
+	year := wallClock(reference, loc).Year()
+
+	candidate := func(year int) (time.Time, bool) {
+		wall, err := time.Parse("2006 Jan 2 15:04:05", fmt.Sprintf("%04d %s %s %s:%s:%s", year, m[1], m[2], m[3], m[4], m[5]))
+		if err != nil {
+			return time.Time{}, false
+		}
+
+		t, err := resolveLocalTime(wall, loc, disambiguationCompatible)
+		if err != nil {
+			return time.Time{}, false
+		}
+
+		return t.In(loc), true
+	}
+
+	var result time.Time
+	found := false
+
+	switch policy {
+	case yearInferenceNearest:
+		for _, y := range []int{year - 1, year, year + 1} {
+			t, ok := candidate(y)
+			if ok && (!found || t.Sub(reference).Abs() < result.Sub(reference).Abs()) {
+				result, found = t, true
+			}
+		}
+	case yearInferencePast:
+		// 29 February recurs at least once in any eight years.
+		for y := year; y >= year-8 && !found; y-- {
+			if t, ok := candidate(y); ok && !t.After(reference) {
+				result, found = t, true
+			}
+		}
+	case yearInferenceCurrent:
+		result, found = candidate(year)
+	}
+
+	if !found {
+		return time.Time{}, fmt.Errorf("%q is not a valid date and time near %s", value, formatTimestamp(reference))
+	}
+
+	return result, nil
+}
+
+// parseRFC5424Timestamp parses the TIMESTAMP of an RFC 5424 syslog message,
+// either alone or as the field after the <PRI>VERSION header of a whole
+// message.
+func parseRFC5424Timestamp(value string) (time.Time, error) {
+	field := value
+	if header := syslogPriorityPattern.FindString(value); header != "" {
+		field, _, _ = strings.Cut(value[len(header):], " ")
+	}
+
+	if field == "-" {
+		return time.Time{}, errors.New("the message has no timestamp (NILVALUE)")
+	}
+
+	if !rfc5424TimestampPattern.MatchString(field) {
+		return time.Time{}, fmt.Errorf("%q is not an RFC 5424 timestamp in YYYY-MM-DDThh:mm:ss[.ffffff](Z|+hh:mm) form", field)
+	}
+
+	return time.Parse(time.RFC3339Nano, field)
+}
+
+// parseCLFTimestamp parses a Common Log Format timestamp such as
+// "15/Jan/2024:10:30:00 +0000", with or without its surrounding brackets.
+func parseCLFTimestamp(value string) (time.Time, error) {
+	if strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+		value = value[1 : len(value)-1]
+	}
+
+	t, err := time.Parse(clfTimestampLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a Common Log Format timestamp in dd/Mmm/yyyy:hh:mm:ss +hhmm form", value)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,283 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxNaturalCount bounds the counts in relative expressions so that adding
+// them cannot overflow a duration.
+const maxNaturalCount = 100000
+
+var (
+	// naturalClockPattern matches a 24-hour H:MM or H:MM:SS time of day.
+	naturalClockPattern = regexp.MustCompile(`^(\d{1,2}):(\d{2})(?::(\d{2}))?$`)
+
+	// naturalMeridiemPattern matches a 12-hour time of day such as 9am or
+	// 9:30pm.
+	naturalMeridiemPattern = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)$`)
+)
+
+// naturalBoundaryUnits are the units whose start or end can be named.
+var naturalBoundaryUnits = []string{unitDay, unitWeek, unitMonth, unitQuarter, unitYear}
+
+// naturalExamples lists supported forms in errors for unrecognized input.
+const naturalExamples = `"now", "in 2 weeks", "3 days ago", "tomorrow 09:00", "next tuesday at 9am" or "end of month"`
+
+// parseNatural resolves an English expression of a time relative to
+// reference on the wall clock of loc, following the grammar documented by
+// parse_natural. Weeks start on Monday, and wall times skipped or repeated
+// by a transition resolve with the compatible policy.
+func parseNatural(expression string, reference time.Time, loc *time.Location) (time.Time, error) {
+	words := strings.Fields(strings.ToLower(strings.ReplaceAll(expression, ",", " ")))
+	if len(words) == 0 {
+		return time.Time{}, errors.New("expression must not be empty")
+	}
+
+	phrase := strings.Join(words, " ")
+
+	var direction int
+	var amounts []string
+
+	switch {
+	case phrase == "now":
+		return reference.In(loc), nil
+	case words[0] == "in":
+		direction, amounts = 1, words[1:]
+	case words[len(words)-1] == "ago":
+		direction, amounts = -1, words[:len(words)-1]
+	case strings.HasSuffix(phrase, " from now"):
+		direction, amounts = 1, words[:len(words)-2]
+	case words[0] == "start" || words[0] == "beginning" || words[0] == "end":
+		wall, err := parseNaturalBoundary(words, reference, loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%q: %w", expression, err)
+		}
+		return resolveNaturalWall(wall, loc), nil
+	}
+
+	if direction != 0 {
+		step, err := parseNaturalAmounts(amounts)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%q: %w", expression, err)
+		}
+		return step.after(reference, direction, loc).In(loc), nil
+	}
+
+	wall, err := parseNaturalDay(words, reference, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q: %w", expression, err)
+	}
+
+	return resolveNaturalWall(wall, loc), nil
+}
+
+// parseNaturalAmounts parses a list of counts and units such as "2 weeks and
+// 3 days" into a step, where "a" or "an" counts one.
+func parseNaturalAmounts(words []string) (calendarStep, error) {
+	var step calendarStep
+
+	words = slices.DeleteFunc(slices.Clone(words), func(w string) bool { return w == "and" })
+	if len(words) == 0 || len(words)%2 != 0 {
+		return calendarStep{}, fmt.Errorf("expected counts followed by units, such as \"2 weeks\" or \"an hour and 30 minutes\", in %s", naturalExamples)
+	}
+
+	for i := 0; i < len(words); i += 2 {
+		count := 1
+		if words[i] != "a" && words[i] != "an" {
+			var err error
+			if count, err = strconv.Atoi(words[i]); err != nil || count < 0 || count > maxNaturalCount {
+				return calendarStep{}, fmt.Errorf("%q is not a whole number from 0 to %d", words[i], maxNaturalCount)
+			}
+		}
+
+		switch strings.TrimSuffix(words[i+1], "s") {
+		case unitSecond:
+			step.duration += time.Duration(count) * time.Second
+		case unitMinute:
+			step.duration += time.Duration(count) * time.Minute
+		case unitHour:
+			step.duration += time.Duration(count) * time.Hour
+		case unitDay:
+			step.days += count
+		case unitWeek:
+			step.days += 7 * count
+		case unitMonth:
+			step.months += count
+		case unitQuarter:
+			step.months += 3 * count
+		case unitYear:
+			step.years += count
+		default:
+			return calendarStep{}, fmt.Errorf("unknown unit %q, expected one of: %s", words[i+1], strings.Join(calendarUnits, ", "))
+		}
+	}
+
+	return step, nil
+}
+
+// parseNaturalBoundary parses "start of", "beginning of" or "end of" a unit,
+// optionally preceded by "the", "this", "next" or "last", returning wall
+// clock fields. The end of a unit is its last whole second.
+func parseNaturalBoundary(words []string, reference time.Time, loc *time.Location) (time.Time, error) {
+	if len(words) < 3 || words[1] != "of" {
+		return time.Time{}, fmt.Errorf("expected %q to be followed by \"of\" and a unit, such as \"%s of month\"", words[0], words[0])
+	}
+
+	offset := 0
+	rest := words[2:]
+	if len(rest) == 2 {
+		switch rest[0] {
+		case "the", "this":
+		case "next":
+			offset = 1
+		case "last":
+			offset = -1
+		default:
+			return time.Time{}, fmt.Errorf("unknown qualifier %q, expected the, this, next or last", rest[0])
+		}
+		rest = rest[1:]
+	}
+
+	if len(rest) != 1 || !slices.Contains(naturalBoundaryUnits, rest[0]) {
+		return time.Time{}, fmt.Errorf("expected one of %s after %q", strings.Join(naturalBoundaryUnits, ", "), strings.Join(words[:2], " "))
+	}
+
+	start, err := startOfUnit(wallClock(reference, loc), rest[0], time.Monday)
+	if err != nil {
+		return time.Time{}, err
+	}
+	start = addUnits(start, rest[0], offset)
+
+	if words[0] == "end" {
+		return addUnits(start, rest[0], 1).Add(-time.Second), nil
+	}
+
+	return start, nil
+}
+
+// parseNaturalDay parses a day followed by an optional time of day,
+// returning wall clock fields. Days are today, tomorrow, yesterday, or the
+// next or last given weekday, which are one to seven days after or before
+// today. Without a time of day the result is midnight.
+func parseNaturalDay(words []string, reference time.Time, loc *time.Location) (time.Time, error) {
+	today, _ := startOfUnit(wallClock(reference, loc), unitDay, time.Monday)
+
+	var day time.Time
+	var rest []string
+
+	switch words[0] {
+	case "today":
+		day, rest = today, words[1:]
+	case "tomorrow":
+		day, rest = today.AddDate(0, 0, 1), words[1:]
+	case "yesterday":
+		day, rest = today.AddDate(0, 0, -1), words[1:]
+	case "next", "last", "this":
+		if len(words) < 2 {
+			return time.Time{}, fmt.Errorf("expected a weekday after %q", words[0])
+		}
+
+		if unit := strings.TrimSuffix(words[1], "s"); slices.Contains(calendarUnits, unit) {
+			return time.Time{}, fmt.Errorf("%q is ambiguous, say \"in 1 %s\", \"1 %s ago\", \"start of %s %s\" or \"end of %s %s\"",
+				strings.Join(words[:2], " "), unit, unit, words[0], unit, words[0], unit)
+		}
+
+		weekday, err := parseWeekday(words[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		switch words[0] {
+		case "next":
+			day = today.AddDate(0, 0, (int(weekday)-int(today.Weekday())+6)%7+1)
+		case "last":
+			day = today.AddDate(0, 0, -((int(today.Weekday())-int(weekday)+6)%7 + 1))
+		default:
+			return time.Time{}, fmt.Errorf("%q is ambiguous, say \"next %s\" or \"last %s\"", strings.Join(words[:2], " "), words[1], words[1])
+		}
+		rest = words[2:]
+	default:
+		if _, err := parseWeekday(words[0]); err == nil {
+			return time.Time{}, fmt.Errorf("a weekday alone is ambiguous, say \"next %s\" or \"last %s\"", words[0], words[0])
+		}
+
+		if _, err := parseNaturalTimeOfDay(strings.Join(slices.DeleteFunc(slices.Clone(words), func(w string) bool { return w == "at" }), "")); err == nil {
+			return time.Time{}, errors.New("a time of day alone is ambiguous, say \"today\" or \"tomorrow\" before it")
+		}
+
+		return time.Time{}, fmt.Errorf("not a supported expression, expected a form such as %s", naturalExamples)
+	}
+
+	if len(rest) > 0 && rest[0] == "at" {
+		rest = rest[1:]
+	}
+
+	if len(rest) == 0 {
+		return day, nil
+	}
+
+	clock, err := parseNaturalTimeOfDay(strings.Join(rest, ""))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return day.Add(clock), nil
+}
+
+// parseNaturalTimeOfDay parses a 24-hour time such as 09:00 or 17:30:15, a
+// 12-hour time such as 9am or 9:30pm, noon or midnight, returning the time
+// since midnight.
+func parseNaturalTimeOfDay(value string) (time.Duration, error) {
+	n := func(s string) int {
+		v, _ := strconv.Atoi(s)
+		return v
+	}
+
+	var hour, minute, second int
+
+	switch m1, m2 := naturalClockPattern.FindStringSubmatch(value), naturalMeridiemPattern.FindStringSubmatch(value); {
+	case value == "noon":
+		hour = 12
+	case value == "midnight":
+	case m1 != nil:
+		hour, minute, second = n(m1[1]), n(m1[2]), n(m1[3])
+		if hour > 23 {
+			return 0, fmt.Errorf("time of day %q has an hour outside 0 to 23", value)
+		}
+	case m2 != nil:
+		hour, minute = n(m2[1]), n(m2[2])
+		if hour < 1 || hour > 12 {
+			return 0, fmt.Errorf("time of day %q has an hour outside 1 to 12", value)
+		}
+		hour %= 12
+		if m2[3] == "pm" {
+			hour += 12
+		}
+	default:
+		return 0, fmt.Errorf("%q is not a time of day such as 09:00, 9am, 9:30pm, noon or midnight", value)
+	}
+
+	if minute > 59 || second > 59 {
+		return 0, fmt.Errorf("time of day %q has minutes or seconds outside 0 to 59", value)
+	}
+
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second, nil
+}
+
+// resolveNaturalWall returns the instant at which the wall clock in loc
+// shows wall, with the compatible policy.
+func resolveNaturalWall(wall time.Time, loc *time.Location) time.Time {
+	// The compatible policy never fails.
+	t, _ := resolveLocalTime(wall, loc, disambiguationCompatible)
+
+	return t.In(loc)
+}
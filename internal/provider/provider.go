@@ -9,9 +9,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ provider.Provider = &TimeUtilsProvider{}
@@ -20,6 +22,10 @@ type TimeUtilsProvider struct {
 	version string
 }
 
+type timeUtilsProviderModel struct {
+	FixedNow types.String `tfsdk:"fixed_now"`
+}
+
 func (p *TimeUtilsProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "timeutils"
 	resp.Version = p.version
@@ -28,19 +34,61 @@ func (p *TimeUtilsProvider) Metadata(ctx context.Context, req provider.MetadataR
 func (p *TimeUtilsProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A provider for advanced time manipulation functions including RFC3339 parsing and strftime formatting.",
+		Attributes: map[string]schema.Attribute{
+			"fixed_now": schema.StringAttribute{
+				Description: "RFC3339 timestamp that resources and data sources use as the current time instead of the system clock, for stable test output. " +
+					"Defaults to the " + fixedNowEnvVar + " environment variable. Functions never read the clock.",
+				Optional: true,
+			},
+		},
 	}
 }
 
 func (p *TimeUtilsProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	// No configuration needed for this provider
+	var data timeUtilsProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.FixedNow.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("fixed_now"), "Unknown fixed_now",
+			"fixed_now must be known when the provider is configured.")
+		return
+	}
+
+	c, err := newClock(data.FixedNow.ValueString())
+	if err != nil {
+		// An invalid environment variable is not an error in the
+		// provider block, so it is not reported against fixed_now.
+		if data.FixedNow.ValueString() == "" {
+			resp.Diagnostics.AddError("Invalid fixed current time", err.Error())
+		} else {
+			resp.Diagnostics.AddAttributeError(path.Root("fixed_now"), "Invalid fixed current time", err.Error())
+		}
+		return
+	}
+
+	pd := &providerData{clock: c}
+	resp.DataSourceData = pd
+	resp.ResourceData = pd
 }
 
 func (p *TimeUtilsProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		NewRotatingResource,
+		NewStaticResource,
+		NewScheduleResource,
+	}
 }
 
 func (p *TimeUtilsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewTimezoneDataSource,
+		NewNowDataSource,
+		NewDeadlineDataSource,
+	}
 }
 
 func (p *TimeUtilsProvider) Functions(ctx context.Context) []func() function.Function {
@@ -49,6 +97,54 @@ func (p *TimeUtilsProvider) Functions(ctx context.Context) []func() function.Fun
 		func() function.Function { return NewStrftimeFunction() },
 		func() function.Function { return NewDaysDifferenceFunction() },
 		func() function.Function { return NewParseRFC3339Function() },
+		func() function.Function { return NewToJulianDayFunction() },
+		func() function.Function { return NewFromJulianDayFunction() },
+		func() function.Function { return NewToMJDFunction() },
+		func() function.Function { return NewFromMJDFunction() },
+		func() function.Function { return NewToOrdinalDateFunction() },
+		func() function.Function { return NewFromOrdinalDateFunction() },
+		func() function.Function { return NewConvertEpochFunction() },
+		func() function.Function { return NewUTCToTAIFunction() },
+		func() function.Function { return NewTAIToUTCFunction() },
+		func() function.Function { return NewUTCToGPSFunction() },
+		func() function.Function { return NewGPSToUTCFunction() },
+		func() function.Function { return NewTZTransitionsFunction() },
+		func() function.Function { return NewLocalToUTCFunction() },
+		func() function.Function { return NewWindowsToIANAFunction() },
+		func() function.Function { return NewIANAToWindowsFunction() },
+		func() function.Function { return NewListTimezonesFunction() },
+		func() function.Function { return NewIsValidTimezoneFunction() },
+		func() function.Function { return NewCanonicalTimezoneFunction() },
+		func() function.Function { return NewTruncateTimeFunction() },
+		func() function.Function { return NewRoundTimeFunction() },
+		func() function.Function { return NewPeriodBoundsFunction() },
+		func() function.Function { return NewFiscalPeriodFunction() },
+		func() function.Function { return NewISOWeekFunction() },
+		func() function.Function { return NewISOWeekStartFunction() },
+		func() function.Function { return NewWeekOfMonthFunction() },
+		func() function.Function { return NewIntervalOverlapsFunction() },
+		func() function.Function { return NewIntervalContainsFunction() },
+		func() function.Function { return NewIntervalIntersectionFunction() },
+		func() function.Function { return NewIntervalUnionFunction() },
+		func() function.Function { return NewIntervalSubtractFunction() },
+		func() function.Function { return NewIntervalGapsFunction() },
+		func() function.Function { return NewTimeRangeFunction() },
+		func() function.Function { return NewTimeCompareFunction() },
+		func() function.Function { return NewTimeSortFunction() },
+		func() function.Function { return NewTimeMinFunction() },
+		func() function.Function { return NewTimeMaxFunction() },
+		func() function.Function { return NewTimeBetweenFunction() },
+		func() function.Function { return NewAgeFunction() },
+		func() function.Function { return NewNextAnniversaryFunction() },
+		func() function.Function { return NewExpiresWithinFunction() },
+		func() function.Function { return NewCertValidityFunction() },
+		func() function.Function { return NewParseGeneralizedTimeFunction() },
+		func() function.Function { return NewParseUTCTimeFunction() },
+		func() function.Function { return NewFormatGeneralizedTimeFunction() },
+		func() function.Function { return NewFormatUTCTimeFunction() },
+		func() function.Function { return NewJWTTimesFunction() },
+		func() function.Function { return NewParseTimestampFunction() },
+		func() function.Function { return NewParseNaturalFunction() },
 	}
 }
 
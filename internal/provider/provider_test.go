@@ -4,7 +4,12 @@
 package provider
 
 import (
+	"context"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestProvider(t *testing.T) {
@@ -25,3 +30,106 @@ func TestProviderMetadata(t *testing.T) {
 		t.Error("Provider should not be nil")
 	}
 }
+
+func TestProviderConfigure(t *testing.T) {
+	testCases := []struct {
+		name      string
+		fixedNow  *string
+		env       string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name: "system clock",
+		},
+		{
+			name:     "attribute",
+			fixedNow: stringPtr("2024-02-29T12:00:00+01:00"),
+			expected: "2024-02-29T12:00:00+01:00",
+		},
+		{
+			name:     "environment variable",
+			env:      "2024-06-01T00:00:00Z",
+			expected: "2024-06-01T00:00:00Z",
+		},
+		{
+			name:     "attribute overrides environment variable",
+			fixedNow: stringPtr("2024-02-29T12:00:00Z"),
+			env:      "2024-06-01T00:00:00Z",
+			expected: "2024-02-29T12:00:00Z",
+		},
+		{
+			name:      "invalid attribute",
+			fixedNow:  stringPtr("2024-02-29"),
+			expectErr: true,
+		},
+		{
+			name:      "invalid environment variable",
+			env:       "now",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(fixedNowEnvVar, tc.env)
+
+			ctx := context.Background()
+			p := New("test")()
+
+			schemaResp := &provider.SchemaResponse{}
+			p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+			objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			if !ok {
+				t.Fatalf("Expected schema to be an object type")
+			}
+
+			var fixedNow interface{}
+			if tc.fixedNow != nil {
+				fixedNow = *tc.fixedNow
+			}
+
+			resp := &provider.ConfigureResponse{}
+			p.Configure(ctx, provider.ConfigureRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"fixed_now": tftypes.NewValue(tftypes.String, fixedNow),
+					}),
+				},
+			}, resp)
+
+			if tc.expectErr {
+				if !resp.Diagnostics.HasError() {
+					t.Errorf("Expected error, but got none")
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			data, ok := resp.ResourceData.(*providerData)
+			if !ok || resp.DataSourceData != resp.ResourceData {
+				t.Fatalf("Expected the same *providerData for resources and data sources, got %T and %T", resp.ResourceData, resp.DataSourceData)
+			}
+
+			if tc.expected == "" {
+				if _, ok := data.clock.(systemClock); !ok {
+					t.Errorf("Expected the system clock, got %T", data.clock)
+				}
+				return
+			}
+
+			if got := formatTimestamp(data.clock.Now()); got != tc.expected {
+				t.Errorf("Expected the clock to be fixed at %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,327 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxRotationBusinessDays bounds rotation_business_days to keep rotations
+// within the years RFC3339 can represent.
+const maxRotationBusinessDays = 100000
+
+var (
+	_ resource.Resource                   = &RotatingResource{}
+	_ resource.ResourceWithValidateConfig = &RotatingResource{}
+	_ resource.ResourceWithConfigure      = &RotatingResource{}
+)
+
+type RotatingResource struct {
+	providerClock
+}
+
+type rotatingResourceModel struct {
+	ID                            types.String `tfsdk:"id"`
+	RFC3339                       types.String `tfsdk:"rfc3339"`
+	Timezone                      types.String `tfsdk:"timezone"`
+	RotationBusinessDays          types.Int64  `tfsdk:"rotation_business_days"`
+	RotationFirstWeekdayOfQuarter types.String `tfsdk:"rotation_first_weekday_of_quarter"`
+	RotationCron                  types.String `tfsdk:"rotation_cron"`
+	PreviousRotationRFC3339       types.String `tfsdk:"previous_rotation_rfc3339"`
+	RotationRFC3339               types.String `tfsdk:"rotation_rfc3339"`
+	NextRotationRFC3339           types.String `tfsdk:"next_rotation_rfc3339"`
+	timestampAttributesModel
+}
+
+func NewRotatingResource() resource.Resource {
+	return &RotatingResource{}
+}
+
+func (r *RotatingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rotating"
+}
+
+func (r *RotatingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(r.configure(req.ProviderData)...)
+}
+
+func (r *RotatingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The stored timestamp, formatted as RFC3339.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"rfc3339": schema.StringAttribute{
+			Description: "RFC3339 formatted timestamp to store. Defaults to the current time when the resource is created, in the configured time zone or UTC.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"timezone": schema.StringAttribute{
+			Description: "IANA time zone whose wall clock the rotation rule is evaluated on. Defaults to the offset of rfc3339.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"rotation_business_days": schema.Int64Attribute{
+			Description: "Rotate after this many business days, Monday to Friday, at the time of day of rfc3339. Exactly one rotation rule must be set.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"rotation_first_weekday_of_quarter": schema.StringAttribute{
+			Description: "Rotate at midnight on the first occurrence of this weekday (e.g., 'monday') in each calendar quarter. Exactly one rotation rule must be set.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"rotation_cron": schema.StringAttribute{
+			Description: "Rotate whenever this five-field cron expression (minute, hour, day of month, month, day of week) or macro such as '@weekly' fires. " +
+				"As in Vixie cron, a day matches either the day of month or the day of week when both are restricted. Exactly one rotation rule must be set.",
+			Optional: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"previous_rotation_rfc3339": schema.StringAttribute{
+			Description: "The last rotation boundary at or before rfc3339, formatted as RFC3339. For business day rotations this is rfc3339 itself.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"rotation_rfc3339": schema.StringAttribute{
+			Description: "The first rotation boundary after rfc3339, formatted as RFC3339. Once it has passed, the resource is removed from state on refresh and planned for creation again.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"next_rotation_rfc3339": schema.StringAttribute{
+			Description: "The rotation boundary after rotation_rfc3339, formatted as RFC3339, when a timestamp stored at the next rotation would itself rotate.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+	maps.Copy(attributes, timestampAttributes("rfc3339"))
+
+	resp.Schema = schema.Schema{
+		Description: "Stores a timestamp that is replaced when a rotation boundary passes. " +
+			"Rotation rules are calendar based: every N business days, the first given weekday of each quarter, or a cron schedule, evaluated on the wall clock of a time zone. " +
+			"Derived attributes such as unix and year describe rfc3339 in its own offset.",
+		Attributes: attributes,
+	}
+}
+
+func (r *RotatingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data rotatingResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules := 0
+	for _, value := range []interface{ IsNull() bool }{data.RotationBusinessDays, data.RotationFirstWeekdayOfQuarter, data.RotationCron} {
+		if !value.IsNull() {
+			rules++
+		}
+	}
+
+	if rules != 1 {
+		resp.Diagnostics.AddError("Invalid rotation rule",
+			"Exactly one of rotation_business_days, rotation_first_weekday_of_quarter and rotation_cron must be set.")
+	}
+
+	if !data.RFC3339.IsNull() && !data.RFC3339.IsUnknown() {
+		if _, err := parseTimestamp(data.RFC3339.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rfc3339"), "Invalid RFC3339 timestamp", err.Error())
+		}
+	}
+
+	if !data.Timezone.IsNull() && !data.Timezone.IsUnknown() {
+		if _, err := loadLocation(data.Timezone.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timezone"), "Invalid time zone", err.Error())
+		}
+	}
+
+	if rules == 1 {
+		_, diags := data.rotationRule(time.UTC)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(validateFormats(ctx, data.Formats)...)
+}
+
+// rotationRule builds the configured rotation rule on the wall clock of loc,
+// ignoring rules whose values are not yet known.
+func (data rotatingResourceModel) rotationRule(loc *time.Location) (rotationRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case !data.RotationBusinessDays.IsNull():
+		days := data.RotationBusinessDays.ValueInt64()
+		if !data.RotationBusinessDays.IsUnknown() && (days < 1 || days > maxRotationBusinessDays) {
+			diags.AddAttributeError(path.Root("rotation_business_days"), "Invalid business days",
+				fmt.Sprintf("rotation_business_days must be between 1 and %d.", maxRotationBusinessDays))
+			return nil, diags
+		}
+		return businessDayRotation{days: int(days), loc: loc}, diags
+	case !data.RotationFirstWeekdayOfQuarter.IsNull():
+		if data.RotationFirstWeekdayOfQuarter.IsUnknown() {
+			return nil, diags
+		}
+		weekday, err := parseWeekday(data.RotationFirstWeekdayOfQuarter.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("rotation_first_weekday_of_quarter"), "Invalid weekday", err.Error())
+			return nil, diags
+		}
+		return quarterWeekdayRotation{weekday: weekday, loc: loc}, diags
+	case !data.RotationCron.IsNull():
+		if data.RotationCron.IsUnknown() {
+			return nil, diags
+		}
+		schedule, err := parseCron(data.RotationCron.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("rotation_cron"), "Invalid cron expression", err.Error())
+			return nil, diags
+		}
+		return cronRotation{schedule: schedule, loc: loc}, diags
+	default:
+		diags.AddError("Invalid rotation rule",
+			"Exactly one of rotation_business_days, rotation_first_weekday_of_quarter and rotation_cron must be set.")
+		return nil, diags
+	}
+}
+
+func (r *RotatingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data rotatingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	base := r.now().UTC().Truncate(time.Second)
+	if !data.RFC3339.IsNull() && !data.RFC3339.IsUnknown() {
+		var err error
+		if base, err = parseTimestamp(data.RFC3339.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rfc3339"), "Invalid RFC3339 timestamp", err.Error())
+			return
+		}
+	}
+
+	loc := base.Location()
+	if !data.Timezone.IsNull() {
+		var err error
+		if loc, err = loadLocation(data.Timezone.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timezone"), "Invalid time zone", err.Error())
+			return
+		}
+	}
+
+	// A configured rfc3339 is kept as written, as Terraform rejects a
+	// normalized value that differs from the plan.
+	if data.RFC3339.IsNull() || data.RFC3339.IsUnknown() {
+		base = base.In(loc)
+		data.RFC3339 = types.StringValue(formatTimestamp(base))
+	}
+
+	rule, diags := data.rotationRule(loc)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, err := rule.previous(base)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to calculate previous rotation", err.Error())
+		return
+	}
+
+	rotation, err := rule.next(base)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to calculate rotation", err.Error())
+		return
+	}
+
+	next, err := rule.next(rotation)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to calculate next rotation", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(formatTimestamp(base))
+	data.PreviousRotationRFC3339 = types.StringValue(formatTimestamp(previous))
+	data.RotationRFC3339 = types.StringValue(formatTimestamp(rotation))
+	data.NextRotationRFC3339 = types.StringValue(formatTimestamp(next))
+
+	resp.Diagnostics.Append(data.timestampAttributesModel.set(ctx, base)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RotatingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data rotatingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotation, err := parseTimestamp(data.RotationRFC3339.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rotation_rfc3339"), "Invalid RFC3339 timestamp in state", err.Error())
+		return
+	}
+
+	if !r.now().Before(rotation) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only stores the plan, as every configurable attribute requires
+// replacement.
+func (r *RotatingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data rotatingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RotatingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,449 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRotatingResourceCreate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           map[string]tftypes.Value
+		expectedPrevious string
+		expectedRotation string
+		expectedNext     string
+		expectErr        string
+	}{
+		{
+			name: "weekly cron",
+			config: map[string]tftypes.Value{
+				"rfc3339":       tftypes.NewValue(tftypes.String, "2024-01-17T12:00:00Z"),
+				"timezone":      tftypes.NewValue(tftypes.String, "Europe/London"),
+				"rotation_cron": tftypes.NewValue(tftypes.String, "0 9 * * mon"),
+			},
+			expectedPrevious: "2024-01-15T09:00:00Z",
+			expectedRotation: "2024-01-22T09:00:00Z",
+			expectedNext:     "2024-01-29T09:00:00Z",
+		},
+		{
+			name: "daily cron keeps local time across daylight saving",
+			config: map[string]tftypes.Value{
+				"rfc3339":       tftypes.NewValue(tftypes.String, "2024-03-09T15:00:00Z"),
+				"timezone":      tftypes.NewValue(tftypes.String, "America/New_York"),
+				"rotation_cron": tftypes.NewValue(tftypes.String, "@daily"),
+			},
+			expectedPrevious: "2024-03-09T00:00:00-05:00",
+			expectedRotation: "2024-03-10T00:00:00-05:00",
+			expectedNext:     "2024-03-11T00:00:00-04:00",
+		},
+		{
+			name: "cron wall time skipped by daylight saving is shifted",
+			config: map[string]tftypes.Value{
+				"rfc3339":       tftypes.NewValue(tftypes.String, "2024-03-09T12:00:00-05:00"),
+				"timezone":      tftypes.NewValue(tftypes.String, "America/New_York"),
+				"rotation_cron": tftypes.NewValue(tftypes.String, "30 2 * * *"),
+			},
+			expectedPrevious: "2024-03-09T02:30:00-05:00",
+			expectedRotation: "2024-03-10T03:30:00-04:00",
+			expectedNext:     "2024-03-11T02:30:00-04:00",
+		},
+		{
+			name: "cron in the timestamp's offset",
+			config: map[string]tftypes.Value{
+				"rfc3339":       tftypes.NewValue(tftypes.String, "2024-01-17T12:00:00+05:30"),
+				"rotation_cron": tftypes.NewValue(tftypes.String, "*/15 9-17 * * *"),
+			},
+			expectedPrevious: "2024-01-17T12:00:00+05:30",
+			expectedRotation: "2024-01-17T12:15:00+05:30",
+			expectedNext:     "2024-01-17T12:30:00+05:30",
+		},
+		{
+			name: "cron with day of month or day of week",
+			config: map[string]tftypes.Value{
+				"rfc3339":       tftypes.NewValue(tftypes.String, "2024-02-10T00:00:00Z"),
+				"rotation_cron": tftypes.NewValue(tftypes.String, "0 6 1,15 * fri"),
+			},
+			expectedPrevious: "2024-02-09T06:00:00Z",
+			expectedRotation: "2024-02-15T06:00:00Z",
+			expectedNext:     "2024-02-16T06:00:00Z",
+		},
+		{
+			name: "cron with names and ranges",
+			config: map[string]tftypes.Value{
+				"rfc3339":       tftypes.NewValue(tftypes.String, "2024-03-29T18:00:00Z"),
+				"rotation_cron": tftypes.NewValue(tftypes.String, "0 12 * JAN-MAR Mon-Fri"),
+			},
+			expectedPrevious: "2024-03-29T12:00:00Z",
+			expectedRotation: "2025-01-01T12:00:00Z",
+			expectedNext:     "2025-01-02T12:00:00Z",
+		},
+		{
+			name: "cron on leap days skips a century",
+			config: map[string]tftypes.Value{
+				"rfc3339":       tftypes.NewValue(tftypes.String, "2097-01-01T00:00:00Z"),
+				"rotation_cron": tftypes.NewValue(tftypes.String, "0 0 29 2 *"),
+			},
+			expectedPrevious: "2096-02-29T00:00:00Z",
+			expectedRotation: "2104-02-29T00:00:00Z",
+			expectedNext:     "2108-02-29T00:00:00Z",
+		},
+		{
+			name: "business days skip the weekend",
+			config: map[string]tftypes.Value{
+				"rfc3339":                tftypes.NewValue(tftypes.String, "2024-01-18T10:00:00+01:00"),
+				"rotation_business_days": tftypes.NewValue(tftypes.Number, 3),
+			},
+			expectedPrevious: "2024-01-18T10:00:00+01:00",
+			expectedRotation: "2024-01-23T10:00:00+01:00",
+			expectedNext:     "2024-01-26T10:00:00+01:00",
+		},
+		{
+			name: "business days in a time zone",
+			config: map[string]tftypes.Value{
+				"rfc3339":                tftypes.NewValue(tftypes.String, "2024-03-08T15:00:00Z"),
+				"timezone":               tftypes.NewValue(tftypes.String, "America/New_York"),
+				"rotation_business_days": tftypes.NewValue(tftypes.Number, 1),
+			},
+			expectedPrevious: "2024-03-08T10:00:00-05:00",
+			expectedRotation: "2024-03-11T10:00:00-04:00",
+			expectedNext:     "2024-03-12T10:00:00-04:00",
+		},
+		{
+			name: "first monday of each quarter",
+			config: map[string]tftypes.Value{
+				"rfc3339":                           tftypes.NewValue(tftypes.String, "2024-05-15T12:00:00Z"),
+				"rotation_first_weekday_of_quarter": tftypes.NewValue(tftypes.String, "monday"),
+			},
+			expectedPrevious: "2024-04-01T00:00:00Z",
+			expectedRotation: "2024-07-01T00:00:00Z",
+			expectedNext:     "2024-10-07T00:00:00Z",
+		},
+		{
+			name: "first monday of the quarter before it arrives",
+			config: map[string]tftypes.Value{
+				"rfc3339":                           tftypes.NewValue(tftypes.String, "2024-10-03T12:00:00+02:00"),
+				"timezone":                          tftypes.NewValue(tftypes.String, "Europe/Berlin"),
+				"rotation_first_weekday_of_quarter": tftypes.NewValue(tftypes.String, "Mon"),
+			},
+			expectedPrevious: "2024-07-01T00:00:00+02:00",
+			expectedRotation: "2024-10-07T00:00:00+02:00",
+			expectedNext:     "2025-01-06T00:00:00+01:00",
+		},
+		{
+			name: "cron that never fires",
+			config: map[string]tftypes.Value{
+				"rfc3339":       tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+				"rotation_cron": tftypes.NewValue(tftypes.String, "0 0 30 2 *"),
+			},
+			expectErr: "never fires",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state, diags := createResource(t, NewRotatingResource(), tc.config)
+
+			if tc.expectErr != "" {
+				if !diags.HasError() {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(diags[0].Detail(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, diags[0].Detail())
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			for name, expected := range map[string]string{
+				"previous_rotation_rfc3339": tc.expectedPrevious,
+				"rotation_rfc3339":          tc.expectedRotation,
+				"next_rotation_rfc3339":     tc.expectedNext,
+			} {
+				if got := stateString(t, state, name); got != expected {
+					t.Errorf("Expected %s %q, got %q", name, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRotatingResourceDerivedAttributes(t *testing.T) {
+	state, diags := createResource(t, NewRotatingResource(), map[string]tftypes.Value{
+		"rfc3339":       tftypes.NewValue(tftypes.String, "2025-01-01T10:30:00+01:00"),
+		"rotation_cron": tftypes.NewValue(tftypes.String, "@monthly"),
+		"formats": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"date":  tftypes.NewValue(tftypes.String, "%Y-%m-%d"),
+			"clock": tftypes.NewValue(tftypes.String, "%H:%M"),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	var data rotatingResourceModel
+	if diags := state.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to read state: %v", diags)
+	}
+
+	if data.ID.ValueString() != "2025-01-01T10:30:00+01:00" {
+		t.Errorf("Expected id to be the stored timestamp, got %q", data.ID.ValueString())
+	}
+
+	for name, tc := range map[string]struct {
+		got  types.Int64
+		want int64
+	}{
+		"unix":     {data.Unix, 1735723800},
+		"unix_ms":  {data.UnixMs, 1735723800000},
+		"hour":     {data.Hour, 10},
+		"iso_year": {data.ISOYear, 2025},
+		"iso_week": {data.ISOWeek, 1},
+		"weekday":  {data.Weekday, 3},
+	} {
+		if tc.got.ValueInt64() != tc.want {
+			t.Errorf("Expected %s %d, got %d", name, tc.want, tc.got.ValueInt64())
+		}
+	}
+
+	formatted := map[string]string{}
+	if diags := data.Formatted.ElementsAs(context.Background(), &formatted, false); diags.HasError() {
+		t.Fatalf("Failed to read formatted: %v", diags)
+	}
+
+	if formatted["date"] != "2025-01-01" || formatted["clock"] != "10:30" {
+		t.Errorf("Unexpected formatted values: %v", formatted)
+	}
+}
+
+func TestRotatingResourceCreateDefaultsToNow(t *testing.T) {
+	state, diags := createResource(t, NewRotatingResource(), map[string]tftypes.Value{
+		"timezone":               tftypes.NewValue(tftypes.String, "Asia/Tokyo"),
+		"rotation_business_days": tftypes.NewValue(tftypes.Number, 5),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	rfc3339 := stateString(t, state, "rfc3339")
+	if !strings.HasSuffix(rfc3339, "+09:00") {
+		t.Errorf("Expected the current time in Asia/Tokyo, got %q", rfc3339)
+	}
+
+	if _, err := parseTimestamp(rfc3339); err != nil {
+		t.Errorf("Expected an RFC3339 timestamp, got %q", rfc3339)
+	}
+}
+
+func TestRotatingResourceCreateKeepsRFC3339(t *testing.T) {
+	state, diags := createResource(t, NewRotatingResource(), map[string]tftypes.Value{
+		"rfc3339":       tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00.000Z"),
+		"rotation_cron": tftypes.NewValue(tftypes.String, "@daily"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if got := stateString(t, state, "rfc3339"); got != "2024-01-01T00:00:00.000Z" {
+		t.Errorf("Expected the configured rfc3339 to be kept, got %q", got)
+	}
+
+	if got := stateString(t, state, "rotation_rfc3339"); got != "2024-01-02T00:00:00Z" {
+		t.Errorf("Expected rotation_rfc3339 %q, got %q", "2024-01-02T00:00:00Z", got)
+	}
+}
+
+func TestRotatingResourceRead(t *testing.T) {
+	testCases := []struct {
+		name          string
+		rfc3339       string
+		expectRemoved bool
+	}{
+		{
+			name:          "rotation passed",
+			rfc3339:       "2020-01-01T00:00:00Z",
+			expectRemoved: true,
+		},
+		{
+			name:    "rotation pending",
+			rfc3339: "2999-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewRotatingResource()
+
+			state, diags := createResource(t, r, map[string]tftypes.Value{
+				"rfc3339":                           tftypes.NewValue(tftypes.String, tc.rfc3339),
+				"rotation_first_weekday_of_quarter": tftypes.NewValue(tftypes.String, "monday"),
+			})
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			refreshed, diags := readResource(t, r, state)
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			if removed := refreshed.Raw.IsNull(); removed != tc.expectRemoved {
+				t.Errorf("Expected removed %v, got %v", tc.expectRemoved, removed)
+			}
+		})
+	}
+}
+
+func TestRotatingResourceValidateConfig(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     map[string]tftypes.Value
+		expectErr  string
+		expectPath string
+	}{
+		{
+			name: "valid",
+			config: map[string]tftypes.Value{
+				"rotation_cron": tftypes.NewValue(tftypes.String, "0 9 * * 1"),
+			},
+		},
+		{
+			name: "unknown rule",
+			config: map[string]tftypes.Value{
+				"rotation_cron": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		{
+			name:      "no rule",
+			config:    map[string]tftypes.Value{},
+			expectErr: "Exactly one of",
+		},
+		{
+			name: "two rules",
+			config: map[string]tftypes.Value{
+				"rotation_cron":          tftypes.NewValue(tftypes.String, "0 9 * * 1"),
+				"rotation_business_days": tftypes.NewValue(tftypes.Number, 5),
+			},
+			expectErr: "Exactly one of",
+		},
+		{
+			name: "cron with too few fields",
+			config: map[string]tftypes.Value{
+				"rotation_cron": tftypes.NewValue(tftypes.String, "0 9 * *"),
+			},
+			expectErr:  "must have 5 fields",
+			expectPath: "rotation_cron",
+		},
+		{
+			name: "cron value out of range",
+			config: map[string]tftypes.Value{
+				"rotation_cron": tftypes.NewValue(tftypes.String, "0 24 * * *"),
+			},
+			expectErr:  "hour field",
+			expectPath: "rotation_cron",
+		},
+		{
+			name: "cron with zero step",
+			config: map[string]tftypes.Value{
+				"rotation_cron": tftypes.NewValue(tftypes.String, "*/0 * * * *"),
+			},
+			expectErr:  "positive whole number",
+			expectPath: "rotation_cron",
+		},
+		{
+			name: "cron with step beyond the field",
+			config: map[string]tftypes.Value{
+				"rotation_cron": tftypes.NewValue(tftypes.String, "1/9223372036854775807 * * * *"),
+			},
+			expectErr:  "no larger than 59",
+			expectPath: "rotation_cron",
+		},
+		{
+			name: "zero business days",
+			config: map[string]tftypes.Value{
+				"rotation_business_days": tftypes.NewValue(tftypes.Number, 0),
+			},
+			expectErr:  "between 1 and",
+			expectPath: "rotation_business_days",
+		},
+		{
+			name: "unknown weekday",
+			config: map[string]tftypes.Value{
+				"rotation_first_weekday_of_quarter": tftypes.NewValue(tftypes.String, "someday"),
+			},
+			expectPath: "rotation_first_weekday_of_quarter",
+			expectErr:  "someday",
+		},
+		{
+			name: "unknown time zone",
+			config: map[string]tftypes.Value{
+				"rotation_cron": tftypes.NewValue(tftypes.String, "@daily"),
+				"timezone":      tftypes.NewValue(tftypes.String, "Mars/Olympus_Mons"),
+			},
+			expectErr:  "unknown time zone",
+			expectPath: "timezone",
+		},
+		{
+			name: "invalid timestamp",
+			config: map[string]tftypes.Value{
+				"rotation_cron": tftypes.NewValue(tftypes.String, "@daily"),
+				"rfc3339":       tftypes.NewValue(tftypes.String, "2024-01-01"),
+			},
+			expectErr:  "cannot parse",
+			expectPath: "rfc3339",
+		},
+		{
+			name: "invalid format",
+			config: map[string]tftypes.Value{
+				"rotation_cron": tftypes.NewValue(tftypes.String, "@daily"),
+				"formats": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"bad": tftypes.NewValue(tftypes.String, "%Q"),
+				}),
+			},
+			expectPath: `formats["bad"]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, ok := NewRotatingResource().(resource.ResourceWithValidateConfig)
+			if !ok {
+				t.Fatalf("Expected resource to implement ResourceWithValidateConfig")
+			}
+
+			diags := validateResource(t, r, tc.config)
+
+			if tc.expectErr == "" && tc.expectPath == "" {
+				if diags.HasError() {
+					t.Errorf("Unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatalf("Expected error, but got none")
+			}
+
+			if !strings.Contains(diags[0].Detail(), tc.expectErr) {
+				t.Errorf("Expected error containing %q, got %q", tc.expectErr, diags[0].Detail())
+			}
+
+			if tc.expectPath != "" {
+				withPath, ok := diags[0].(interface{ Path() path.Path })
+				if !ok || withPath.Path().String() != tc.expectPath {
+					t.Errorf("Expected error at %s, got %v", tc.expectPath, diags[0])
+				}
+			}
+		})
+	}
+}
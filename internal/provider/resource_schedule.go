@@ -0,0 +1,397 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// defaultScheduleCount is the number of occurrences listed when count
+	// is not set.
+	defaultScheduleCount = 10
+
+	// maxScheduleCount bounds count to keep plans readable.
+	maxScheduleCount = 1000
+)
+
+var (
+	_ resource.Resource                   = &ScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &ScheduleResource{}
+	_ resource.ResourceWithConfigure      = &ScheduleResource{}
+	_ resource.ResourceWithModifyPlan     = &ScheduleResource{}
+)
+
+type ScheduleResource struct {
+	providerClock
+}
+
+type scheduleResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Cron           types.String `tfsdk:"cron"`
+	RRule          types.String `tfsdk:"rrule"`
+	Start          types.String `tfsdk:"start"`
+	Timezone       types.String `tfsdk:"timezone"`
+	Horizon        types.String `tfsdk:"horizon"`
+	Count          types.Int64  `tfsdk:"count"`
+	Occurrences    types.List   `tfsdk:"occurrences"`
+	NextOccurrence types.String `tfsdk:"next_occurrence"`
+	CalculatedAt   types.String `tfsdk:"calculated_at"`
+}
+
+func NewScheduleResource() resource.Resource {
+	return &ScheduleResource{}
+}
+
+func (r *ScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+func (r *ScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(r.configure(req.ProviderData)...)
+}
+
+func (r *ScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the upcoming occurrences of a cron or RFC 5545 recurrence rule on the wall clock of a time zone, up to a horizon. " +
+			"Occurrences are recalculated during plan, so the plan shows the new list once an occurrence has passed or a new one comes within the horizon, and are otherwise kept unchanged in state. " +
+			"Changing the rule, start, time zone or horizon replaces the resource, while changing count updates it in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The recurrence rule, either cron or rrule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cron": schema.StringAttribute{
+				Description: "Five-field cron expression (minute, hour, day of month, month, day of week) or macro such as '@weekly'. " +
+					"As in Vixie cron, a day matches either the day of month or the day of week when both are restricted. Exactly one of cron and rrule must be set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rrule": schema.StringAttribute{
+				Description: "RFC 5545 recurrence rule such as 'FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=17;BYMINUTE=0', with or without the 'RRULE:' prefix. " +
+					"FREQ may be YEARLY, MONTHLY, WEEKLY, DAILY or HOURLY, with INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY, BYDAY, BYHOUR, BYMINUTE, BYSETPOS and WKST. " +
+					"Requires start. Exactly one of cron and rrule must be set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start": schema.StringAttribute{
+				Description: "RFC3339 formatted timestamp before which there are no occurrences. For rrule it is the DTSTART that anchors INTERVAL and COUNT and supplies the time of day, weekday and day of month the rule does not set; it is only an occurrence itself when it matches the rule.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA time zone whose wall clock the rule is evaluated on. Skipped wall times occur shifted forward by the length of the gap, and repeated ones occur once, at the earlier instant.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"horizon": schema.StringAttribute{
+				Description: "How far ahead of calculated_at to list occurrences, as a Go duration ('72h'), an ISO 8601 duration ('P1M') or a calendar unit with an optional count ('2 weeks').",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"count": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of occurrences to list, from 1 to %d. Defaults to %d.", maxScheduleCount, defaultScheduleCount),
+				Optional:    true,
+			},
+			"occurrences": schema.ListAttribute{
+				Description: "The occurrences after calculated_at and within the horizon, in order, formatted as RFC3339 in timezone.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"next_occurrence": schema.StringAttribute{
+				Description: "The first element of occurrences, or null when there are none within the horizon.",
+				Computed:    true,
+			},
+			"calculated_at": schema.StringAttribute{
+				Description: "The time occurrences were last calculated, formatted as RFC3339 in timezone.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *ScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data scheduleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Cron.IsNull() == data.RRule.IsNull() {
+		resp.Diagnostics.AddError("Invalid recurrence rule", "Exactly one of cron and rrule must be set.")
+	}
+
+	if !data.Cron.IsNull() && !data.Cron.IsUnknown() {
+		if _, err := parseCron(data.Cron.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("cron"), "Invalid cron expression", err.Error())
+		}
+	}
+
+	if !data.RRule.IsNull() && !data.RRule.IsUnknown() {
+		if _, err := parseRRule(data.RRule.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rrule"), "Invalid recurrence rule", err.Error())
+		}
+	}
+
+	if !data.RRule.IsNull() && data.Start.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("start"), "Missing start", "start must be set with rrule, as the rule's DTSTART.")
+	}
+
+	if !data.Start.IsNull() && !data.Start.IsUnknown() {
+		if _, err := parseTimestamp(data.Start.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("start"), "Invalid RFC3339 timestamp", err.Error())
+		}
+	}
+
+	if !data.Timezone.IsNull() && !data.Timezone.IsUnknown() {
+		if _, err := loadLocation(data.Timezone.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timezone"), "Invalid time zone", err.Error())
+		}
+	}
+
+	if !data.Horizon.IsNull() && !data.Horizon.IsUnknown() {
+		if _, err := parseCalendarStep(data.Horizon.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("horizon"), "Invalid horizon", err.Error())
+		}
+	}
+
+	if !data.Count.IsNull() && !data.Count.IsUnknown() {
+		if count := data.Count.ValueInt64(); count < 1 || count > maxScheduleCount {
+			resp.Diagnostics.AddAttributeError(path.Root("count"), "Invalid count",
+				fmt.Sprintf("count must be between 1 and %d.", maxScheduleCount))
+		}
+	}
+}
+
+// ModifyPlan recalculates the occurrences from the current time. The plan
+// keeps the stored values when the list is unchanged, and otherwise shows
+// the new list, which apply then stores.
+func (r *ScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan scheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, value := range []attr.Value{plan.Cron, plan.RRule, plan.Start, plan.Timezone, plan.Horizon, plan.Count} {
+		if value.IsUnknown() {
+			return
+		}
+	}
+
+	now := r.now()
+
+	occurrences, diags := plan.occurrences(now)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() && !plan.Occurrences.IsUnknown() {
+		var state scheduleResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.Occurrences.Equal(occurrencesList(occurrences)) {
+			return
+		}
+	}
+
+	plan.set(now, occurrences)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// occurrences calculates up to count occurrences of the schedule after now
+// and within the horizon.
+func (data scheduleResourceModel) occurrences(now time.Time) ([]time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	loc, err := loadLocation(data.Timezone.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("timezone"), "Invalid time zone", err.Error())
+		return nil, diags
+	}
+
+	horizon, err := parseCalendarStep(data.Horizon.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("horizon"), "Invalid horizon", err.Error())
+		return nil, diags
+	}
+
+	limit := defaultScheduleCount
+	if !data.Count.IsNull() {
+		limit = int(data.Count.ValueInt64())
+	}
+
+	var start time.Time
+	if !data.Start.IsNull() {
+		if start, err = parseTimestamp(data.Start.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("start"), "Invalid RFC3339 timestamp", err.Error())
+			return nil, diags
+		}
+	}
+
+	now = now.Truncate(time.Second)
+	end := horizon.after(now, 1, loc)
+
+	if !data.RRule.IsNull() {
+		rule, err := parseRRule(data.RRule.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("rrule"), "Invalid recurrence rule", err.Error())
+			return nil, diags
+		}
+
+		occurrences, err := rule.occurrences(start, loc, now, end, limit)
+		if err != nil {
+			diags.AddError("Failed to calculate occurrences", err.Error())
+		}
+
+		return occurrences, diags
+	}
+
+	schedule, err := parseCron(data.Cron.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("cron"), "Invalid cron expression", err.Error())
+		return nil, diags
+	}
+
+	// Occurrences fire strictly after t, so start itself may fire.
+	t := now
+	if start.After(now) {
+		t = start.Add(-time.Nanosecond)
+	}
+
+	var occurrences []time.Time
+	for len(occurrences) < limit {
+		if t, err = schedule.next(t, loc); err != nil {
+			diags.AddError("Failed to calculate occurrences", err.Error())
+			return nil, diags
+		}
+
+		if t.After(end) {
+			break
+		}
+
+		occurrences = append(occurrences, t)
+	}
+
+	return occurrences, diags
+}
+
+// set stores occurrences calculated at now.
+func (data *scheduleResourceModel) set(now time.Time, occurrences []time.Time) {
+	loc, _ := loadLocation(data.Timezone.ValueString())
+
+	data.ID = data.Cron
+	if !data.RRule.IsNull() {
+		data.ID = data.RRule
+	}
+
+	data.Occurrences = occurrencesList(occurrences)
+	data.NextOccurrence = types.StringNull()
+	if len(occurrences) > 0 {
+		data.NextOccurrence = types.StringValue(formatTimestamp(occurrences[0]))
+	}
+	data.CalculatedAt = types.StringValue(formatTimestamp(now.Truncate(time.Second).In(loc)))
+}
+
+// occurrencesList converts occurrences to a list of RFC3339 strings.
+func occurrencesList(occurrences []time.Time) types.List {
+	elems := make([]attr.Value, len(occurrences))
+	for i, t := range occurrences {
+		elems[i] = types.StringValue(formatTimestamp(t))
+	}
+
+	return types.ListValueMust(types.StringType, elems)
+}
+
+func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data scheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.apply(r.now())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply stores the occurrences shown in the plan, calculating them at now
+// when the plan could not, such as when the rule was unknown during plan.
+func (data *scheduleResourceModel) apply(now time.Time) diag.Diagnostics {
+	if !data.Occurrences.IsUnknown() {
+		return nil
+	}
+
+	occurrences, diags := data.occurrences(now)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.set(now, occurrences)
+
+	return diags
+}
+
+// Read keeps the stored state, as occurrences are recalculated during plan.
+func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data scheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.apply(r.now())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,517 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// scheduleOccurrences returns the occurrences attribute of a schedule.
+func scheduleOccurrences(t *testing.T, state interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}) []string {
+	t.Helper()
+
+	var list types.List
+	if diags := state.GetAttribute(context.Background(), path.Root("occurrences"), &list); diags.HasError() {
+		t.Fatalf("Failed to read occurrences: %v", diags)
+	}
+
+	var occurrences []string
+	if diags := list.ElementsAs(context.Background(), &occurrences, false); diags.HasError() {
+		t.Fatalf("Failed to read occurrences: %v", diags)
+	}
+
+	return occurrences
+}
+
+func TestScheduleResourceCreate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rule      string
+		value     string
+		start     string
+		timezone  string
+		count     int64
+		expected  []string
+		expectErr string
+	}{
+		{
+			name:     "last friday of the month",
+			rule:     "rrule",
+			value:    "FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=17;BYMINUTE=0",
+			start:    "2100-01-01T00:00:00Z",
+			timezone: "UTC",
+			count:    3,
+			expected: []string{"2100-01-29T17:00:00Z", "2100-02-26T17:00:00Z", "2100-03-26T17:00:00Z"},
+		},
+		{
+			name:     "fortnightly on two weekdays",
+			rule:     "rrule",
+			value:    "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+			start:    "2100-01-06T09:30:00Z",
+			timezone: "UTC",
+			count:    4,
+			expected: []string{"2100-01-06T09:30:00Z", "2100-01-18T09:30:00Z", "2100-01-20T09:30:00Z", "2100-02-01T09:30:00Z"},
+		},
+		{
+			name:     "leap day skips years without one",
+			rule:     "rrule",
+			value:    "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29",
+			start:    "2100-01-01T12:00:00Z",
+			timezone: "UTC",
+			count:    2,
+			expected: []string{"2104-02-29T12:00:00Z", "2108-02-29T12:00:00Z"},
+		},
+		{
+			name:     "day of month skips shorter months",
+			rule:     "rrule",
+			value:    "FREQ=MONTHLY",
+			start:    "2100-01-31T08:00:00Z",
+			timezone: "UTC",
+			count:    3,
+			expected: []string{"2100-01-31T08:00:00Z", "2100-03-31T08:00:00Z", "2100-05-31T08:00:00Z"},
+		},
+		{
+			name:     "last weekday of the month",
+			rule:     "rrule",
+			value:    "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			start:    "2100-01-01T18:00:00Z",
+			timezone: "UTC",
+			count:    3,
+			expected: []string{"2100-01-29T18:00:00Z", "2100-02-26T18:00:00Z", "2100-03-31T18:00:00Z"},
+		},
+		{
+			name:     "nth weekday of the year",
+			rule:     "rrule",
+			value:    "FREQ=YEARLY;BYDAY=20MO",
+			start:    "2100-01-01T00:00:00Z",
+			timezone: "UTC",
+			count:    2,
+			expected: []string{"2100-05-17T00:00:00Z", "2101-05-16T00:00:00Z"},
+		},
+		{
+			name:     "count ends the rule",
+			rule:     "rrule",
+			value:    "FREQ=DAILY;COUNT=3",
+			start:    "2100-01-01T06:00:00+01:00",
+			timezone: "Europe/Berlin",
+			expected: []string{"2100-01-01T06:00:00+01:00", "2100-01-02T06:00:00+01:00", "2100-01-03T06:00:00+01:00"},
+		},
+		{
+			name:     "until ends the rule",
+			rule:     "rrule",
+			value:    "FREQ=DAILY;UNTIL=21000102T120000Z",
+			start:    "2100-01-01T12:00:00Z",
+			timezone: "UTC",
+			expected: []string{"2100-01-01T12:00:00Z", "2100-01-02T12:00:00Z"},
+		},
+		{
+			name:     "rrule wall time skipped by daylight saving is shifted",
+			rule:     "rrule",
+			value:    "FREQ=DAILY;BYHOUR=2;BYMINUTE=30",
+			start:    "2100-03-13T00:00:00-05:00",
+			timezone: "America/New_York",
+			count:    3,
+			expected: []string{"2100-03-13T02:30:00-05:00", "2100-03-14T03:30:00-04:00", "2100-03-15T02:30:00-04:00"},
+		},
+		{
+			name:     "cron from start",
+			rule:     "cron",
+			value:    "0 9 * * mon-fri",
+			start:    "2100-01-01T09:00:00+01:00",
+			timezone: "Europe/Paris",
+			count:    3,
+			expected: []string{"2100-01-01T09:00:00+01:00", "2100-01-04T09:00:00+01:00", "2100-01-05T09:00:00+01:00"},
+		},
+		{
+			name:      "cron that never fires",
+			rule:      "cron",
+			value:     "0 0 30 2 *",
+			timezone:  "UTC",
+			expectErr: "never fires",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]tftypes.Value{
+				tc.rule:    tftypes.NewValue(tftypes.String, tc.value),
+				"timezone": tftypes.NewValue(tftypes.String, tc.timezone),
+				"horizon":  tftypes.NewValue(tftypes.String, "200 years"),
+			}
+			if tc.start != "" {
+				config["start"] = tftypes.NewValue(tftypes.String, tc.start)
+			}
+			if tc.count != 0 {
+				config["count"] = tftypes.NewValue(tftypes.Number, tc.count)
+			}
+
+			state, diags := createResource(t, NewScheduleResource(), config)
+
+			if tc.expectErr != "" {
+				if !diags.HasError() {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(diags[0].Detail(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, diags[0].Detail())
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			if got := scheduleOccurrences(t, state); !slices.Equal(got, tc.expected) {
+				t.Errorf("Expected occurrences %v, got %v", tc.expected, got)
+			}
+
+			if got := stateString(t, state, "next_occurrence"); got != tc.expected[0] {
+				t.Errorf("Expected next_occurrence %q, got %q", tc.expected[0], got)
+			}
+
+			if got := stateString(t, state, "id"); got != tc.value {
+				t.Errorf("Expected id %q, got %q", tc.value, got)
+			}
+		})
+	}
+}
+
+func TestScheduleResourceCreateDistantStart(t *testing.T) {
+	t.Setenv(fixedNowEnvVar, "2024-01-15T10:30:00Z")
+
+	testCases := []struct {
+		name      string
+		value     string
+		expected  []string
+		expectErr string
+	}{
+		{
+			name:     "hourly skips ahead to the present",
+			value:    "FREQ=HOURLY;BYMINUTE=0",
+			expected: []string{"2024-01-15T11:00:00Z", "2024-01-15T12:00:00Z"},
+		},
+		{
+			name:     "skipping ahead keeps the interval aligned to start",
+			value:    "FREQ=DAILY;INTERVAL=3;BYHOUR=6;BYMINUTE=0",
+			expected: []string{"2024-01-16T06:00:00Z", "2024-01-19T06:00:00Z"},
+		},
+		{
+			name:      "count must be expanded from start",
+			value:     "FREQ=HOURLY;COUNT=100000000",
+			expectErr: "move start closer to the present",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state, diags := createResource(t, NewScheduleResource(), map[string]tftypes.Value{
+				"rrule":    tftypes.NewValue(tftypes.String, tc.value),
+				"start":    tftypes.NewValue(tftypes.String, "0001-01-01T00:00:00Z"),
+				"timezone": tftypes.NewValue(tftypes.String, "UTC"),
+				"horizon":  tftypes.NewValue(tftypes.String, "7 days"),
+				"count":    tftypes.NewValue(tftypes.Number, 2),
+			})
+
+			if tc.expectErr != "" {
+				if !diags.HasError() {
+					t.Errorf("Expected error, but got none")
+				} else if !strings.Contains(diags[0].Detail(), tc.expectErr) {
+					t.Errorf("Expected error containing %q, got %q", tc.expectErr, diags[0].Detail())
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			if got := scheduleOccurrences(t, state); !slices.Equal(got, tc.expected) {
+				t.Errorf("Expected occurrences %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestScheduleResourceCreateWithinHorizon(t *testing.T) {
+	before := time.Now().Truncate(time.Second)
+
+	state, diags := createResource(t, NewScheduleResource(), map[string]tftypes.Value{
+		"cron":     tftypes.NewValue(tftypes.String, "@hourly"),
+		"timezone": tftypes.NewValue(tftypes.String, "Asia/Kolkata"),
+		"horizon":  tftypes.NewValue(tftypes.String, "PT3H"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	calculatedAt, err := parseTimestamp(stateString(t, state, "calculated_at"))
+	if err != nil {
+		t.Fatalf("Expected an RFC3339 calculated_at: %v", err)
+	}
+
+	if calculatedAt.Before(before) || calculatedAt.After(time.Now()) {
+		t.Errorf("Expected calculated_at to be the current time, got %s", calculatedAt)
+	}
+
+	occurrences := scheduleOccurrences(t, state)
+	if len(occurrences) != 3 {
+		t.Fatalf("Expected 3 hourly occurrences within 3 hours, got %v", occurrences)
+	}
+
+	for _, occurrence := range occurrences {
+		got, err := parseTimestamp(occurrence)
+		if err != nil {
+			t.Fatalf("Expected an RFC3339 occurrence: %v", err)
+		}
+
+		if !got.After(calculatedAt) || got.After(calculatedAt.Add(3*time.Hour)) || got.Minute() != 0 || !strings.HasSuffix(occurrence, "+05:30") {
+			t.Errorf("Unexpected occurrence %q after %s", occurrence, calculatedAt)
+		}
+	}
+}
+
+func TestScheduleResourceModifyPlan(t *testing.T) {
+	r := NewScheduleResource()
+
+	state, diags := createResource(t, r, map[string]tftypes.Value{
+		"cron":     tftypes.NewValue(tftypes.String, "@daily"),
+		"timezone": tftypes.NewValue(tftypes.String, "UTC"),
+		"horizon":  tftypes.NewValue(tftypes.String, "P3D"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	modifier, ok := r.(resource.ResourceWithModifyPlan)
+	if !ok {
+		t.Fatalf("Expected resource to implement ResourceWithModifyPlan")
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		plan, diags := modifyResourcePlan(t, modifier, state, state.Raw.Copy())
+		if diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+
+		if !plan.Raw.Equal(state.Raw) {
+			t.Errorf("Expected the plan to keep the stored occurrences")
+		}
+	})
+
+	t.Run("occurrence passed", func(t *testing.T) {
+		stale := tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()}
+		for name, value := range map[string]string{
+			"next_occurrence": "2020-01-01T00:00:00Z",
+			"calculated_at":   "2019-12-31T12:00:00Z",
+		} {
+			if diags := stale.SetAttribute(context.Background(), path.Root(name), value); diags.HasError() {
+				t.Fatalf("Failed to set %s: %v", name, diags)
+			}
+		}
+		if diags := stale.SetAttribute(context.Background(), path.Root("occurrences"), []string{"2020-01-01T00:00:00Z"}); diags.HasError() {
+			t.Fatalf("Failed to set occurrences: %v", diags)
+		}
+
+		plan, diags := modifyResourcePlan(t, modifier, stale, stale.Raw.Copy())
+		if diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+
+		if got, expected := scheduleOccurrences(t, plan), scheduleOccurrences(t, state); !slices.Equal(got, expected) {
+			t.Errorf("Expected the plan to show occurrences %v, got %v", expected, got)
+		}
+
+		var calculatedAt types.String
+		plan.GetAttribute(context.Background(), path.Root("calculated_at"), &calculatedAt)
+		if calculatedAt.ValueString() == "2019-12-31T12:00:00Z" {
+			t.Errorf("Expected calculated_at to be updated")
+		}
+	})
+
+	t.Run("count changed", func(t *testing.T) {
+		_, proposed := resourceConfig(t, r, map[string]tftypes.Value{
+			"id":       tftypes.NewValue(tftypes.String, "@daily"),
+			"cron":     tftypes.NewValue(tftypes.String, "@daily"),
+			"timezone": tftypes.NewValue(tftypes.String, "UTC"),
+			"horizon":  tftypes.NewValue(tftypes.String, "P3D"),
+			"count":    tftypes.NewValue(tftypes.Number, 1),
+		}, true)
+
+		plan, diags := modifyResourcePlan(t, modifier, state, proposed)
+		if diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+
+		if got, expected := scheduleOccurrences(t, plan), scheduleOccurrences(t, state)[:1]; !slices.Equal(got, expected) {
+			t.Errorf("Expected the plan to show occurrences %v, got %v", expected, got)
+		}
+	})
+}
+
+func TestScheduleResourceValidateConfig(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     map[string]tftypes.Value
+		expectPath string
+		expectErr  bool
+	}{
+		{
+			name: "cron",
+			config: map[string]tftypes.Value{
+				"cron": tftypes.NewValue(tftypes.String, "*/15 * * * *"),
+			},
+		},
+		{
+			name: "rrule with start",
+			config: map[string]tftypes.Value{
+				"rrule": tftypes.NewValue(tftypes.String, "FREQ=WEEKLY;BYDAY=MO;BYHOUR=9"),
+				"start": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+			},
+		},
+		{
+			name:      "no rule",
+			config:    map[string]tftypes.Value{},
+			expectErr: true,
+		},
+		{
+			name: "both rules",
+			config: map[string]tftypes.Value{
+				"cron":  tftypes.NewValue(tftypes.String, "@daily"),
+				"rrule": tftypes.NewValue(tftypes.String, "FREQ=DAILY"),
+				"start": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid cron",
+			config: map[string]tftypes.Value{
+				"cron": tftypes.NewValue(tftypes.String, "0 25 * * *"),
+			},
+			expectPath: "cron",
+		},
+		{
+			name: "cron with step beyond the field",
+			config: map[string]tftypes.Value{
+				"cron": tftypes.NewValue(tftypes.String, "1/9223372036854775807 * * * *"),
+			},
+			expectPath: "cron",
+		},
+		{
+			name: "unsupported rrule part",
+			config: map[string]tftypes.Value{
+				"rrule": tftypes.NewValue(tftypes.String, "FREQ=YEARLY;BYWEEKNO=20"),
+				"start": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+			},
+			expectPath: "rrule",
+		},
+		{
+			name: "rrule with count and until",
+			config: map[string]tftypes.Value{
+				"rrule": tftypes.NewValue(tftypes.String, "FREQ=DAILY;COUNT=2;UNTIL=20240110"),
+				"start": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+			},
+			expectPath: "rrule",
+		},
+		{
+			name: "rrule ordinal with weekly frequency",
+			config: map[string]tftypes.Value{
+				"rrule": tftypes.NewValue(tftypes.String, "FREQ=WEEKLY;BYDAY=1MO"),
+				"start": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+			},
+			expectPath: "rrule",
+		},
+		{
+			name: "rrule without start",
+			config: map[string]tftypes.Value{
+				"rrule": tftypes.NewValue(tftypes.String, "FREQ=DAILY"),
+			},
+			expectPath: "start",
+		},
+		{
+			name: "invalid start",
+			config: map[string]tftypes.Value{
+				"cron":  tftypes.NewValue(tftypes.String, "@daily"),
+				"start": tftypes.NewValue(tftypes.String, "2024-01-01"),
+			},
+			expectPath: "start",
+		},
+		{
+			name: "invalid time zone",
+			config: map[string]tftypes.Value{
+				"cron":     tftypes.NewValue(tftypes.String, "@daily"),
+				"timezone": tftypes.NewValue(tftypes.String, "Mars/Olympus_Mons"),
+			},
+			expectPath: "timezone",
+		},
+		{
+			name: "invalid horizon",
+			config: map[string]tftypes.Value{
+				"cron":    tftypes.NewValue(tftypes.String, "@daily"),
+				"horizon": tftypes.NewValue(tftypes.String, "forever"),
+			},
+			expectPath: "horizon",
+		},
+		{
+			name: "count out of range",
+			config: map[string]tftypes.Value{
+				"cron":  tftypes.NewValue(tftypes.String, "@daily"),
+				"count": tftypes.NewValue(tftypes.Number, 0),
+			},
+			expectPath: "count",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, ok := NewScheduleResource().(resource.ResourceWithValidateConfig)
+			if !ok {
+				t.Fatalf("Expected resource to implement ResourceWithValidateConfig")
+			}
+
+			config := map[string]tftypes.Value{
+				"timezone": tftypes.NewValue(tftypes.String, "UTC"),
+				"horizon":  tftypes.NewValue(tftypes.String, "30 days"),
+			}
+			for name, value := range tc.config {
+				config[name] = value
+			}
+
+			diags := validateResource(t, r, config)
+
+			if tc.expectPath == "" && !tc.expectErr {
+				if diags.HasError() {
+					t.Errorf("Unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatalf("Expected error, but got none")
+			}
+
+			if tc.expectPath != "" {
+				withPath, ok := diags[0].(interface{ Path() path.Path })
+				if !ok || withPath.Path().String() != tc.expectPath {
+					t.Errorf("Expected error at %s, got %v", tc.expectPath, diags[0])
+				}
+			}
+
+			if strings.TrimSpace(diags[0].Detail()) == "" {
+				t.Errorf("Expected error detail, got none")
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &StaticResource{}
+	_ resource.ResourceWithValidateConfig = &StaticResource{}
+	_ resource.ResourceWithConfigure      = &StaticResource{}
+)
+
+type StaticResource struct {
+	providerClock
+}
+
+type staticResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	RFC3339    types.String `tfsdk:"rfc3339"`
+	Triggers   types.Map    `tfsdk:"triggers"`
+	Zones      types.List   `tfsdk:"zones"`
+	LocalTimes types.Map    `tfsdk:"local_times"`
+	timestampAttributesModel
+}
+
+func NewStaticResource() resource.Resource {
+	return &StaticResource{}
+}
+
+func (r *StaticResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_static"
+}
+
+func (r *StaticResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(r.configure(req.ProviderData)...)
+}
+
+func (r *StaticResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The stored timestamp, formatted as RFC3339.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"rfc3339": schema.StringAttribute{
+			Description: "RFC3339 formatted timestamp to store. Defaults to the current time in UTC when the resource is created.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			Description: "Arbitrary map of values that, when changed, replace the resource and capture a new current time.",
+			ElementType: types.StringType,
+			Optional:    true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"zones": schema.ListAttribute{
+			Description: "IANA time zone names to convert rfc3339 into in local_times.",
+			ElementType: types.StringType,
+			Optional:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+		},
+		"local_times": schema.MapNestedAttribute{
+			Description: "Map of the names in zones to rfc3339 on that zone's wall clock.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.UseStateForUnknown(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"rfc3339": schema.StringAttribute{
+						Description: "The stored timestamp in the time zone, formatted as RFC3339.",
+						Computed:    true,
+					},
+					"offset": schema.StringAttribute{
+						Description: "UTC offset in effect at the stored timestamp (e.g., '+01:00').",
+						Computed:    true,
+					},
+					"abbreviation": schema.StringAttribute{
+						Description: "Time zone abbreviation in effect at the stored timestamp (e.g., 'CET'). Zones without an abbreviation report the numeric offset.",
+						Computed:    true,
+					},
+					"is_dst": schema.BoolAttribute{
+						Description: "Whether daylight saving time is in effect at the stored timestamp.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+	maps.Copy(attributes, timestampAttributes("rfc3339"))
+
+	resp.Schema = schema.Schema{
+		Description: "Stores a timestamp once, either the current time at creation or a given one, and derives Unix times, calendar and ISO week fields, strftime formats and local times in other zones from it. " +
+			"Derived attributes such as unix and year describe rfc3339 in its own offset.",
+		Attributes: attributes,
+	}
+}
+
+func (r *StaticResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data staticResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RFC3339.IsNull() && !data.RFC3339.IsUnknown() {
+		if _, err := parseTimestamp(data.RFC3339.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rfc3339"), "Invalid RFC3339 timestamp", err.Error())
+		}
+	}
+
+	resp.Diagnostics.Append(validateZones(data.Zones)...)
+	resp.Diagnostics.Append(validateFormats(ctx, data.Formats)...)
+}
+
+func (r *StaticResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data staticResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A configured rfc3339 is kept as written, as Terraform rejects a
+	// normalized value that differs from the plan.
+	t := r.now().UTC().Truncate(time.Second)
+	if !data.RFC3339.IsNull() && !data.RFC3339.IsUnknown() {
+		var err error
+		if t, err = parseTimestamp(data.RFC3339.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rfc3339"), "Invalid RFC3339 timestamp", err.Error())
+			return
+		}
+	} else {
+		data.RFC3339 = types.StringValue(formatTimestamp(t))
+	}
+
+	var diags diag.Diagnostics
+	data.LocalTimes, diags = zoneTimes(ctx, data.Zones, t)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(formatTimestamp(t))
+
+	resp.Diagnostics.Append(data.timestampAttributesModel.set(ctx, t)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the stored state, as the timestamp never changes once captured.
+func (r *StaticResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update only stores the plan, as every configurable attribute requires
+// replacement.
+func (r *StaticResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data staticResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StaticResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,229 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStaticResourceCreate(t *testing.T) {
+	zones := tftypes.List{ElementType: tftypes.String}
+	formats := tftypes.Map{ElementType: tftypes.String}
+
+	state, diags := createResource(t, NewStaticResource(), map[string]tftypes.Value{
+		"rfc3339": tftypes.NewValue(tftypes.String, "2024-12-30T23:30:00-05:00"),
+		"zones": tftypes.NewValue(zones, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "Europe/Berlin"),
+			tftypes.NewValue(tftypes.String, "Asia/Kolkata"),
+			tftypes.NewValue(tftypes.String, "America/Sao_Paulo"),
+		}),
+		"formats": tftypes.NewValue(formats, map[string]tftypes.Value{
+			"date": tftypes.NewValue(tftypes.String, "%Y-%m-%d"),
+			"name": tftypes.NewValue(tftypes.String, "backup-%Y%m%d-%H%M"),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	var data staticResourceModel
+	if diags := state.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to read state: %v", diags)
+	}
+
+	if data.ID.ValueString() != "2024-12-30T23:30:00-05:00" {
+		t.Errorf("Expected id to be the stored timestamp, got %q", data.ID.ValueString())
+	}
+
+	for name, tc := range map[string]struct {
+		got  types.Int64
+		want int64
+	}{
+		"unix":        {data.Unix, 1735619400},
+		"unix_ms":     {data.UnixMs, 1735619400000},
+		"year":        {data.Year, 2024},
+		"month":       {data.Month, 12},
+		"day":         {data.Day, 30},
+		"hour":        {data.Hour, 23},
+		"minute":      {data.Minute, 30},
+		"second":      {data.Second, 0},
+		"weekday":     {data.Weekday, 1},
+		"day_of_year": {data.DayOfYear, 365},
+		"iso_year":    {data.ISOYear, 2025},
+		"iso_week":    {data.ISOWeek, 1},
+	} {
+		if tc.got.ValueInt64() != tc.want {
+			t.Errorf("Expected %s %d, got %d", name, tc.want, tc.got.ValueInt64())
+		}
+	}
+
+	if data.JulianDay.ValueString() != "2460675.6875" {
+		t.Errorf("Expected julian_day 2460675.6875, got %q", data.JulianDay.ValueString())
+	}
+
+	formatted := map[string]string{}
+	if diags := data.Formatted.ElementsAs(context.Background(), &formatted, false); diags.HasError() {
+		t.Fatalf("Failed to read formatted: %v", diags)
+	}
+
+	if formatted["date"] != "2024-12-30" || formatted["name"] != "backup-20241230-2330" {
+		t.Errorf("Unexpected formatted values: %v", formatted)
+	}
+
+	localTimes := map[string]struct {
+		RFC3339      types.String `tfsdk:"rfc3339"`
+		Offset       types.String `tfsdk:"offset"`
+		Abbreviation types.String `tfsdk:"abbreviation"`
+		IsDST        types.Bool   `tfsdk:"is_dst"`
+	}{}
+	if diags := data.LocalTimes.ElementsAs(context.Background(), &localTimes, false); diags.HasError() {
+		t.Fatalf("Failed to read local_times: %v", diags)
+	}
+
+	expected := map[string][3]string{
+		"Europe/Berlin":     {"2024-12-31T05:30:00+01:00", "+01:00", "CET"},
+		"Asia/Kolkata":      {"2024-12-31T10:00:00+05:30", "+05:30", "IST"},
+		"America/Sao_Paulo": {"2024-12-31T01:30:00-03:00", "-03:00", "-03:00"},
+	}
+	if len(localTimes) != len(expected) {
+		t.Errorf("Expected %d local times, got %d", len(expected), len(localTimes))
+	}
+	for zone, want := range expected {
+		got := localTimes[zone]
+		if got.RFC3339.ValueString() != want[0] || got.Offset.ValueString() != want[1] || got.Abbreviation.ValueString() != want[2] || got.IsDST.ValueBool() {
+			t.Errorf("Unexpected local time in %s: %v", zone, got)
+		}
+	}
+}
+
+func TestStaticResourceCreateDefaultsToNow(t *testing.T) {
+	before := time.Now().Truncate(time.Second)
+
+	state, diags := createResource(t, NewStaticResource(), map[string]tftypes.Value{})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	got, err := parseTimestamp(stateString(t, state, "rfc3339"))
+	if err != nil {
+		t.Fatalf("Expected an RFC3339 timestamp: %v", err)
+	}
+
+	if got.Before(before) || got.After(time.Now()) || got.Location() != time.UTC {
+		t.Errorf("Expected the current time in UTC, got %s", got)
+	}
+}
+
+func TestStaticResourceCreateFixedNow(t *testing.T) {
+	t.Setenv(fixedNowEnvVar, "2024-02-29T12:34:56.789+01:00")
+
+	state, diags := createResource(t, NewStaticResource(), map[string]tftypes.Value{})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if got := stateString(t, state, "rfc3339"); got != "2024-02-29T11:34:56Z" {
+		t.Errorf("Expected the fixed time in UTC, got %q", got)
+	}
+}
+
+func TestStaticResourceCreateKeepsRFC3339(t *testing.T) {
+	state, diags := createResource(t, NewStaticResource(), map[string]tftypes.Value{
+		"rfc3339": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00.500Z"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	var data staticResourceModel
+	if diags := state.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to read state: %v", diags)
+	}
+
+	if data.RFC3339.ValueString() != "2024-01-01T00:00:00.500Z" {
+		t.Errorf("Expected the configured rfc3339 to be kept, got %q", data.RFC3339.ValueString())
+	}
+
+	if data.UnixMs.ValueInt64() != 1704067200500 {
+		t.Errorf("Expected unix_ms 1704067200500, got %d", data.UnixMs.ValueInt64())
+	}
+}
+
+func TestStaticResourceValidateConfig(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     map[string]tftypes.Value
+		expectPath string
+	}{
+		{
+			name:   "empty",
+			config: map[string]tftypes.Value{},
+		},
+		{
+			name: "invalid timestamp",
+			config: map[string]tftypes.Value{
+				"rfc3339": tftypes.NewValue(tftypes.String, "1735619400"),
+			},
+			expectPath: "rfc3339",
+		},
+		{
+			name: "unknown zone",
+			config: map[string]tftypes.Value{
+				"zones": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "UTC"),
+					tftypes.NewValue(tftypes.String, "Europe/Atlantis"),
+				}),
+			},
+			expectPath: "zones[1]",
+		},
+		{
+			name: "invalid format",
+			config: map[string]tftypes.Value{
+				"formats": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"bad": tftypes.NewValue(tftypes.String, "%Q"),
+				}),
+			},
+			expectPath: `formats["bad"]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, ok := NewStaticResource().(resource.ResourceWithValidateConfig)
+			if !ok {
+				t.Fatalf("Expected resource to implement ResourceWithValidateConfig")
+			}
+
+			diags := validateResource(t, r, tc.config)
+
+			if tc.expectPath == "" {
+				if diags.HasError() {
+					t.Errorf("Unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatalf("Expected error, but got none")
+			}
+
+			withPath, ok := diags[0].(interface{ Path() path.Path })
+			if !ok || withPath.Path().String() != tc.expectPath {
+				t.Errorf("Expected error at %s, got %v", tc.expectPath, diags[0])
+			}
+
+			if strings.TrimSpace(diags[0].Detail()) == "" {
+				t.Errorf("Expected error detail, got none")
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"time"
+)
+
+// rotationRule computes the rotation boundaries of a rotating timestamp.
+type rotationRule interface {
+	// previous returns the last boundary at or before t.
+	previous(t time.Time) (time.Time, error)
+	// next returns the first boundary after t.
+	next(t time.Time) (time.Time, error)
+}
+
+// businessDayRotation rotates every days business days, Monday to Friday,
+// at the time of day of the timestamp it is anchored to.
+type businessDayRotation struct {
+	days int
+	loc  *time.Location
+}
+
+// previous returns t itself, as business day rotations count from the
+// timestamp they rotate rather than from fixed calendar boundaries.
+func (r businessDayRotation) previous(t time.Time) (time.Time, error) {
+	return t.In(r.loc), nil
+}
+
+func (r businessDayRotation) next(t time.Time) (time.Time, error) {
+	wall := wallClock(t, r.loc)
+	for n := r.days; n > 0; {
+		wall = wall.AddDate(0, 0, 1)
+		if wall.Weekday() != time.Saturday && wall.Weekday() != time.Sunday {
+			n--
+		}
+	}
+
+	if wall.Year() > 9999 {
+		return time.Time{}, errors.New("rotation is after year 9999")
+	}
+
+	next, err := resolveLocalTime(wall, r.loc, disambiguationCompatible)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return next.In(r.loc), nil
+}
+
+// quarterWeekdayRotation rotates at midnight on the first given weekday of
+// each calendar quarter.
+type quarterWeekdayRotation struct {
+	weekday time.Weekday
+	loc     *time.Location
+}
+
+// boundary returns the rotation in the quarter offset quarters from the
+// one containing t.
+func (r quarterWeekdayRotation) boundary(t time.Time, offset int) (time.Time, error) {
+	quarter, _ := startOfUnit(wallClock(t, r.loc), unitQuarter, time.Monday)
+	first := addUnits(quarter, unitQuarter, offset)
+	wall := first.AddDate(0, 0, (int(r.weekday)-int(first.Weekday())+7)%7)
+
+	boundary, err := resolveLocalTime(wall, r.loc, disambiguationCompatible)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return boundary.In(r.loc), nil
+}
+
+func (r quarterWeekdayRotation) previous(t time.Time) (time.Time, error) {
+	for offset := 0; ; offset-- {
+		boundary, err := r.boundary(t, offset)
+		if err != nil || !boundary.After(t) {
+			return boundary, err
+		}
+	}
+}
+
+func (r quarterWeekdayRotation) next(t time.Time) (time.Time, error) {
+	for offset := 0; ; offset++ {
+		boundary, err := r.boundary(t, offset)
+		if err != nil || boundary.After(t) {
+			return boundary, err
+		}
+	}
+}
+
+// cronRotation rotates whenever a cron schedule fires on the wall clock of
+// loc.
+type cronRotation struct {
+	schedule cronSchedule
+	loc      *time.Location
+}
+
+func (r cronRotation) previous(t time.Time) (time.Time, error) {
+	return r.schedule.previous(t, r.loc)
+}
+
+func (r cronRotation) next(t time.Time) (time.Time, error) {
+	return r.schedule.next(t, r.loc)
+}
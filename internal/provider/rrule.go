@@ -0,0 +1,478 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence frequencies supported in RRULE FREQ, mapped to the calendar
+// unit each period spans.
+var rruleFrequencies = map[string]string{
+	"YEARLY":  unitYear,
+	"MONTHLY": unitMonth,
+	"WEEKLY":  unitWeek,
+	"DAILY":   unitDay,
+	"HOURLY":  unitHour,
+}
+
+// maxRRulePeriods bounds the periods a rule is expanded over, so that a start
+// far in the past cannot make every plan iterate for a long time.
+const maxRRulePeriods = 200000
+
+var rruleWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// rruleWeekday is a BYDAY entry such as MO, or 2MO and -1FR for the second
+// Monday or last Friday of the month or year.
+type rruleWeekday struct {
+	ordinal int
+	weekday time.Weekday
+}
+
+// rrule is a parsed RFC 5545 recurrence rule. It supports FREQ from YEARLY
+// to HOURLY with INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY, BYDAY,
+// BYHOUR, BYMINUTE, BYSETPOS and WKST.
+type rrule struct {
+	unit       string
+	interval   int
+	count      int
+	until      time.Time
+	untilLocal bool
+	byMonth    []int
+	byMonthDay []int
+	byDay      []rruleWeekday
+	byHour     []int
+	byMinute   []int
+	bySetPos   []int
+	weekStart  time.Weekday
+}
+
+// parseRRule parses the value of an RRULE property, with or without the
+// "RRULE:" prefix, such as "FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=9;BYMINUTE=0".
+func parseRRule(value string) (rrule, error) {
+	r := rrule{interval: 1, weekStart: time.Monday}
+
+	body := strings.TrimSpace(value)
+	if len(body) >= 6 && strings.EqualFold(body[:6], "RRULE:") {
+		body = body[6:]
+	}
+
+	seen := map[string]bool{}
+	for _, part := range strings.Split(body, ";") {
+		name, partValue, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if !ok || partValue == "" {
+			return rrule{}, fmt.Errorf("rule part %q must be NAME=VALUE", part)
+		}
+		if seen[name] {
+			return rrule{}, fmt.Errorf("rule part %s is repeated", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			unit, ok := rruleFrequencies[strings.ToUpper(partValue)]
+			if !ok {
+				return rrule{}, fmt.Errorf("unsupported FREQ %q, expected one of: YEARLY, MONTHLY, WEEKLY, DAILY, HOURLY", partValue)
+			}
+			r.unit = unit
+		case "INTERVAL":
+			r.interval, err = rrulePositive(name, partValue)
+		case "COUNT":
+			r.count, err = rrulePositive(name, partValue)
+		case "UNTIL":
+			r.until, r.untilLocal, err = parseRRuleUntil(partValue)
+		case "BYMONTH":
+			r.byMonth, err = rruleIntegers(name, partValue, 1, 12, false)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = rruleIntegers(name, partValue, 1, 31, true)
+		case "BYHOUR":
+			r.byHour, err = rruleIntegers(name, partValue, 0, 23, false)
+		case "BYMINUTE":
+			r.byMinute, err = rruleIntegers(name, partValue, 0, 59, false)
+		case "BYSETPOS":
+			r.bySetPos, err = rruleIntegers(name, partValue, 1, 366, true)
+		case "BYDAY":
+			r.byDay, err = parseRRuleWeekdays(partValue)
+		case "WKST":
+			var days []rruleWeekday
+			if days, err = parseRRuleWeekdays(partValue); err == nil {
+				if len(days) != 1 || days[0].ordinal != 0 {
+					err = fmt.Errorf("WKST %q must be a single weekday", partValue)
+				} else {
+					r.weekStart = days[0].weekday
+				}
+			}
+		default:
+			return rrule{}, fmt.Errorf("unsupported rule part %q, expected FREQ, INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY, BYDAY, BYHOUR, BYMINUTE, BYSETPOS or WKST", name)
+		}
+		if err != nil {
+			return rrule{}, err
+		}
+	}
+
+	switch {
+	case r.unit == "":
+		return rrule{}, errors.New("FREQ is required")
+	case r.count > 0 && !r.until.IsZero():
+		return rrule{}, errors.New("COUNT and UNTIL must not both be set")
+	case r.unit != unitYear && r.unit != unitMonth && slices.ContainsFunc(r.byDay, func(d rruleWeekday) bool { return d.ordinal != 0 }):
+		return rrule{}, errors.New("BYDAY ordinals such as 1MO are only allowed with FREQ=MONTHLY or FREQ=YEARLY")
+	case r.unit == unitWeek && len(r.byMonthDay) > 0:
+		return rrule{}, errors.New("BYMONTHDAY is not allowed with FREQ=WEEKLY")
+	}
+
+	return r, nil
+}
+
+func rrulePositive(name, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%s %q must be a positive whole number", name, value)
+	}
+
+	return n, nil
+}
+
+// rruleIntegers parses a comma-separated list of integers from min to max,
+// also allowing -max to -min when negative is set.
+func rruleIntegers(name, value string, min, max int, negative bool) ([]int, error) {
+	var values []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || (n < min || n > max) && (!negative || n > -min || n < -max) {
+			return nil, fmt.Errorf("%s value %q is out of range", name, item)
+		}
+		values = append(values, n)
+	}
+
+	return values, nil
+}
+
+// parseRRuleWeekdays parses BYDAY values such as MO, 2TU or -1FR.
+func parseRRuleWeekdays(value string) ([]rruleWeekday, error) {
+	var days []rruleWeekday
+	for _, item := range strings.Split(strings.ToUpper(value), ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("weekday %q is not one of SU, MO, TU, WE, TH, FR, SA", item)
+		}
+
+		weekday := slices.Index(rruleWeekdays, item[len(item)-2:])
+		if weekday < 0 {
+			return nil, fmt.Errorf("weekday %q is not one of SU, MO, TU, WE, TH, FR, SA", item)
+		}
+
+		ordinal := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("weekday ordinal %q must be from 1 to 53 or -53 to -1", prefix)
+			}
+			ordinal = n
+		}
+
+		days = append(days, rruleWeekday{ordinal: ordinal, weekday: time.Weekday(weekday)})
+	}
+
+	return days, nil
+}
+
+// parseRRuleUntil parses an UNTIL date or date-time. Times ending in Z are
+// UTC instants and others are local wall clock times, returned as UTC with
+// local set. A date alone includes the whole of that day.
+func parseRRuleUntil(value string) (time.Time, bool, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, false, nil
+	}
+
+	if t, err := time.Parse("20060102T150405", value); err == nil {
+		return t, true, nil
+	}
+
+	if t, err := time.Parse("20060102", value); err == nil {
+		return t.Add(24*time.Hour - time.Nanosecond), true, nil
+	}
+
+	return time.Time{}, false, fmt.Errorf("UNTIL %q must be a date (YYYYMMDD) or date-time (YYYYMMDDTHHMMSS, optionally ending in Z)", value)
+}
+
+// occurrences returns up to limit occurrences of the rule anchored at start
+// on the wall clock of loc that are after after and not after end. Start
+// fixes the time of day, weekday and day of month that the BY parts do not
+// set, and is only an occurrence itself when it matches the rule.
+func (r rrule) occurrences(start time.Time, loc *time.Location, after, end time.Time, limit int) ([]time.Time, error) {
+	startWall := wallClock(start, loc)
+	endWall := wallClock(end, loc).Add(24 * time.Hour)
+
+	until := r.until
+	if r.untilLocal {
+		var err error
+		if until, err = resolveLocalTime(r.until, loc, disambiguationCompatible); err != nil {
+			return nil, err
+		}
+	}
+
+	var result []time.Time
+	emitted := 0
+
+	period, err := startOfUnit(startWall, r.unit, r.weekStart)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without COUNT, occurrences before after need not be counted, so skip
+	// to the last period aligned to INTERVAL that starts at least one
+	// interval before the period containing after.
+	if r.count == 0 && after.After(start) {
+		afterPeriod, err := startOfUnit(wallClock(after, loc), r.unit, r.weekStart)
+		if err != nil {
+			return nil, err
+		}
+
+		if skip := periodsBetween(period, afterPeriod, r.unit)/r.interval - 1; skip > 0 {
+			period = addUnits(period, r.unit, skip*r.interval)
+		}
+	}
+
+	for periods := 0; !period.After(endWall) && period.Year() <= 9999; period = addUnits(period, r.unit, r.interval) {
+		if periods++; periods > maxRRulePeriods {
+			return nil, fmt.Errorf("rule spans more than %d periods before reaching the horizon; move start closer to the present", maxRRulePeriods)
+		}
+
+		for _, wall := range r.candidates(period, startWall) {
+			if wall.Before(startWall) {
+				continue
+			}
+
+			instant, err := resolveLocalTime(wall, loc, disambiguationCompatible)
+			if err != nil {
+				return nil, err
+			}
+
+			if !r.until.IsZero() && instant.After(until) || instant.After(end) {
+				return result, nil
+			}
+
+			emitted++
+			if r.count > 0 && emitted > r.count {
+				return result, nil
+			}
+
+			if instant.After(after) {
+				result = append(result, instant.In(loc))
+				if len(result) == limit {
+					return result, nil
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// periodsBetween returns the whole number of units from the period starting
+// at from to the period starting at to, both produced by startOfUnit. Unix
+// seconds are used as durations overflow after 292 years.
+func periodsBetween(from, to time.Time, unit string) int {
+	switch unit {
+	case unitYear:
+		return to.Year() - from.Year()
+	case unitMonth:
+		return 12*(to.Year()-from.Year()) + int(to.Month()) - int(from.Month())
+	case unitWeek:
+		return int((to.Unix() - from.Unix()) / (7 * 24 * 3600))
+	case unitDay:
+		return int((to.Unix() - from.Unix()) / (24 * 3600))
+	default:
+		return int((to.Unix() - from.Unix()) / 3600)
+	}
+}
+
+// candidates returns the sorted wall clock times the rule generates in the
+// period starting at period, after applying BYSETPOS.
+func (r rrule) candidates(period, start time.Time) []time.Time {
+	var times []time.Time
+	for _, date := range r.dates(period, start) {
+		hours := r.byHour
+		if r.unit == unitHour {
+			if len(hours) > 0 && !slices.Contains(hours, period.Hour()) {
+				continue
+			}
+			hours = []int{period.Hour()}
+		} else if len(hours) == 0 {
+			hours = []int{start.Hour()}
+		}
+
+		minutes := r.byMinute
+		if len(minutes) == 0 {
+			minutes = []int{start.Minute()}
+		}
+
+		for _, hour := range hours {
+			for _, minute := range minutes {
+				times = append(times, time.Date(date.Year(), date.Month(), date.Day(), hour, minute, start.Second(), 0, time.UTC))
+			}
+		}
+	}
+
+	slices.SortFunc(times, time.Time.Compare)
+	times = slices.CompactFunc(times, time.Time.Equal)
+
+	if len(r.bySetPos) == 0 {
+		return times
+	}
+
+	var selected []time.Time
+	for _, pos := range r.bySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(times) + pos
+		}
+		if i >= 0 && i < len(times) {
+			selected = append(selected, times[i])
+		}
+	}
+
+	slices.SortFunc(selected, time.Time.Compare)
+
+	return slices.CompactFunc(selected, time.Time.Equal)
+}
+
+// dates returns the dates the rule generates in the period starting at
+// period, as midnight UTC.
+func (r rrule) dates(period, start time.Time) []time.Time {
+	year, month, day := period.Date()
+
+	switch r.unit {
+	case unitYear:
+		if len(r.byMonth) == 0 && len(r.byMonthDay) == 0 && len(r.byDay) > 0 {
+			first := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+			return r.weekdaysIn(first, first.AddDate(1, 0, 0))
+		}
+
+		months := r.byMonth
+		if len(months) == 0 {
+			if len(r.byMonthDay) > 0 || len(r.byDay) > 0 {
+				months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+			} else {
+				months = []int{int(start.Month())}
+			}
+		}
+
+		var dates []time.Time
+		for _, m := range slices.Sorted(slices.Values(months)) {
+			dates = append(dates, r.monthDates(year, time.Month(m), start)...)
+		}
+		return dates
+	case unitMonth:
+		if len(r.byMonth) > 0 && !slices.Contains(r.byMonth, int(month)) {
+			return nil
+		}
+		return r.monthDates(year, month, start)
+	case unitWeek:
+		weekdays := []time.Weekday{start.Weekday()}
+		if len(r.byDay) > 0 {
+			weekdays = nil
+			for _, d := range r.byDay {
+				weekdays = append(weekdays, d.weekday)
+			}
+		}
+
+		var dates []time.Time
+		for _, weekday := range weekdays {
+			date := period.AddDate(0, 0, (int(weekday)-int(period.Weekday())+7)%7)
+			if len(r.byMonth) == 0 || slices.Contains(r.byMonth, int(date.Month())) {
+				dates = append(dates, date)
+			}
+		}
+		return dates
+	default:
+		date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		switch {
+		case len(r.byMonth) > 0 && !slices.Contains(r.byMonth, int(month)):
+			return nil
+		case len(r.byMonthDay) > 0 && !slices.Contains(r.monthDays(year, month), day):
+			return nil
+		case len(r.byDay) > 0 && !slices.ContainsFunc(r.byDay, func(d rruleWeekday) bool { return d.weekday == date.Weekday() }):
+			return nil
+		}
+		return []time.Time{date}
+	}
+}
+
+// monthDates returns the dates the rule generates within one month.
+func (r rrule) monthDates(year int, month time.Month, start time.Time) []time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+
+	switch {
+	case len(r.byDay) > 0:
+		dates := r.weekdaysIn(first, first.AddDate(0, 1, 0))
+		if len(r.byMonthDay) > 0 {
+			days := r.monthDays(year, month)
+			dates = slices.DeleteFunc(dates, func(d time.Time) bool { return !slices.Contains(days, d.Day()) })
+		}
+		return dates
+	case len(r.byMonthDay) > 0:
+		var dates []time.Time
+		for _, d := range r.monthDays(year, month) {
+			dates = append(dates, first.AddDate(0, 0, d-1))
+		}
+		return dates
+	case start.Day() <= last:
+		return []time.Time{first.AddDate(0, 0, start.Day()-1)}
+	default:
+		// Like RFC 5545, months without the start's day are skipped.
+		return nil
+	}
+}
+
+// monthDays resolves BYMONTHDAY, including days counted from the end of the
+// month, to the valid days of the given month.
+func (r rrule) monthDays(year int, month time.Month) []int {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	var days []int
+	for _, d := range r.byMonthDay {
+		if d < 0 {
+			d = last + 1 + d
+		}
+		if d >= 1 && d <= last {
+			days = append(days, d)
+		}
+	}
+
+	return days
+}
+
+// weekdaysIn returns the dates from first up to end matching BYDAY, where
+// ordinals count occurrences of the weekday within that span.
+func (r rrule) weekdaysIn(first, end time.Time) []time.Time {
+	var dates []time.Time
+	for _, d := range r.byDay {
+		var matches []time.Time
+		for date := first.AddDate(0, 0, (int(d.weekday)-int(first.Weekday())+7)%7); date.Before(end); date = date.AddDate(0, 0, 7) {
+			matches = append(matches, date)
+		}
+
+		switch {
+		case d.ordinal == 0:
+			dates = append(dates, matches...)
+		case d.ordinal > 0 && d.ordinal <= len(matches):
+			dates = append(dates, matches[d.ordinal-1])
+		case d.ordinal < 0 && -d.ordinal <= len(matches):
+			dates = append(dates, matches[len(matches)+d.ordinal])
+		}
+	}
+
+	slices.SortFunc(dates, time.Time.Compare)
+
+	return slices.CompactFunc(dates, time.Time.Equal)
+}
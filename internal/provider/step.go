@@ -0,0 +1,135 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isoDurationPattern matches ISO 8601 durations such as P1M, P2W or
+// P1DT12H, with an optional fraction on the seconds.
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d{1,9})?)S)?)?$`)
+
+// unitStepPattern matches a calendar unit with an optional count, such as
+// "month", "1 month" or "2 weeks".
+var unitStepPattern = regexp.MustCompile(`^(?:(\d+)\s*)?([a-z]+?)s?$`)
+
+// calendarStep is an amount of time to step by. Years, months and days are
+// added on the wall clock so that they keep the local time of day across
+// daylight saving transitions, while duration is elapsed time.
+type calendarStep struct {
+	years    int
+	months   int
+	days     int
+	duration time.Duration
+}
+
+// parseCalendarStep parses a Go duration ("36h"), an ISO 8601 duration
+// ("P1M", "PT15M") or a calendar unit with an optional count ("1 month").
+func parseCalendarStep(value string) (calendarStep, error) {
+	var step calendarStep
+
+	if m := isoDurationPattern.FindStringSubmatch(value); m != nil && value != "P" && !strings.HasSuffix(value, "T") {
+		n := func(s string) int {
+			v, _ := strconv.Atoi(s)
+			return v
+		}
+
+		step.years = n(m[1])
+		step.months = n(m[2])
+		step.days = 7*n(m[3]) + n(m[4])
+		step.duration = time.Duration(n(m[5]))*time.Hour + time.Duration(n(m[6]))*time.Minute
+
+		if m[7] != "" {
+			seconds, err := strconv.ParseFloat(m[7], 64)
+			if err != nil {
+				return calendarStep{}, fmt.Errorf("invalid seconds in %q: %w", value, err)
+			}
+			step.duration += time.Duration(math.Round(seconds * float64(time.Second)))
+		}
+	} else if d, err := time.ParseDuration(value); err == nil {
+		step.duration = d
+	} else if m := unitStepPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value))); m != nil {
+		count := 1
+		if m[1] != "" {
+			if count, err = strconv.Atoi(m[1]); err != nil {
+				return calendarStep{}, fmt.Errorf("invalid count in %q: %w", value, err)
+			}
+		}
+
+		switch m[2] {
+		case unitSecond:
+			step.duration = time.Duration(count) * time.Second
+		case unitMinute:
+			step.duration = time.Duration(count) * time.Minute
+		case unitHour:
+			step.duration = time.Duration(count) * time.Hour
+		case unitDay:
+			step.days = count
+		case unitWeek:
+			step.days = 7 * count
+		case unitMonth:
+			step.months = count
+		case unitQuarter:
+			step.months = 3 * count
+		case unitYear:
+			step.years = count
+		default:
+			return calendarStep{}, fmt.Errorf("unknown unit in step %q, expected one of: %s", value, strings.Join(calendarUnits, ", "))
+		}
+	} else {
+		return calendarStep{}, fmt.Errorf("step %q is not a Go duration (\"36h\"), an ISO 8601 duration (\"P1M\") or a calendar unit (\"1 month\")", value)
+	}
+
+	if step.years < 0 || step.months < 0 || step.days < 0 || step.duration < 0 || step == (calendarStep{}) {
+		return calendarStep{}, fmt.Errorf("step %q must be positive", value)
+	}
+
+	return step, nil
+}
+
+// isCalendar reports whether the step has a wall clock part.
+func (s calendarStep) isCalendar() bool {
+	return s.years != 0 || s.months != 0 || s.days != 0
+}
+
+// after returns the instant n steps after start on the wall clock in loc.
+// Months are added to the original day of month, clamped to the end of
+// shorter months, so monthly steps from January 31st give the last day of
+// each month. Skipped wall times move forward past the gap.
+func (s calendarStep) after(start time.Time, n int, loc *time.Location) time.Time {
+	if !s.isCalendar() {
+		return addDurations(start, n, s.duration)
+	}
+
+	wall := addMonthsClamped(wallClock(start, loc), n*(12*s.years+s.months)).AddDate(0, 0, n*s.days)
+
+	// The compatible policy never fails.
+	t, _ := resolveLocalTime(wall, loc, disambiguationCompatible)
+
+	return addDurations(t, n, s.duration).In(loc)
+}
+
+// addDurations adds n times d to t. Whole seconds and nanoseconds are
+// multiplied separately, as n times d overflows a duration after 292 years.
+func addDurations(t time.Time, n int, d time.Duration) time.Time {
+	seconds := int64(n) * int64(d/time.Second)
+	nanoseconds := int64(n) * int64(d%time.Second)
+
+	return time.Unix(t.Unix()+seconds+nanoseconds/int64(time.Second), int64(t.Nanosecond())+nanoseconds%int64(time.Second)).In(t.Location())
+}
+
+// addMonthsClamped adds months to wall clock fields, clamping the day to the
+// last day of the resulting month.
+func addMonthsClamped(wall time.Time, months int) time.Time {
+	first := time.Date(wall.Year(), wall.Month(), 1, wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), time.UTC).AddDate(0, months, 0)
+	last := time.Date(first.Year(), first.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	return first.AddDate(0, 0, min(wall.Day(), last)-1)
+}
@@ -0,0 +1,96 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"strings"
+)
+
+// maxSuggestions is the number of close matches included in error messages.
+const maxSuggestions = 3
+
+// closeMatches returns up to maxSuggestions candidates that resemble input,
+// closest first, comparing case-insensitively. Candidates containing input
+// are preferred over those that are merely a small edit away.
+func closeMatches(input string, candidates []string) []string {
+	type match struct {
+		name      string
+		substring bool
+		distance  int
+	}
+
+	needle := strings.ToLower(input)
+	if needle == "" {
+		return nil
+	}
+
+	threshold := max(2, len(needle)/3)
+
+	var matches []match
+	for _, c := range candidates {
+		haystack := strings.ToLower(c)
+
+		d := editDistance(needle, haystack)
+		if strings.Contains(haystack, needle) {
+			matches = append(matches, match{c, true, d})
+		} else if d <= threshold {
+			matches = append(matches, match{c, false, d})
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b match) int {
+		if a.substring != b.substring {
+			if a.substring {
+				return -1
+			}
+			return 1
+		}
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return strings.Compare(a.name, b.name)
+	})
+
+	var names []string
+	for _, m := range matches[:min(len(matches), maxSuggestions)] {
+		names = append(names, m.name)
+	}
+
+	return names
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+// suggestionSuffix formats close matches for appending to an error message.
+func suggestionSuffix(input string, candidates []string) string {
+	matches := closeMatches(input, candidates)
+	if len(matches) == 0 {
+		return ""
+	}
+
+	return `, did you mean "` + strings.Join(matches, `", "`) + `"?`
+}
@@ -0,0 +1,208 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timestampFormat is a named textual representation of an instant.
+type timestampFormat struct {
+	name  string
+	parse func(string) (time.Time, error)
+}
+
+// timestampFormats are the representations accepted by functions that take
+// timestamps in any format the provider understands, tried in order. Each
+// must reject the others' inputs so that detection is unambiguous.
+var timestampFormats = []timestampFormat{
+	{name: "rfc3339", parse: parseTimestamp},
+	{name: "date", parse: parseDate},
+	{name: "iso_week_date", parse: parseISOWeekDate},
+	{name: "ordinal_date", parse: parseDashedOrdinalDate},
+	{name: "unix", parse: parseUnixSeconds},
+}
+
+// timestampFormatSummary describes timestampFormats in error messages.
+const timestampFormatSummary = "an RFC3339 timestamp, a YYYY-MM-DD date, an ISO week date (YYYY-Www-D), an ordinal date (YYYY-DDD) or Unix seconds"
+
+// timestampParseOptions is the context supplied to formats that are selected
+// by name, for representations without an offset or a year.
+type timestampParseOptions struct {
+	loc           *time.Location
+	reference     *time.Time
+	yearInference string
+}
+
+// namedTimestampFormat is a representation that is only parsed when selected
+// by name, because it needs options or overlaps with other representations.
+type namedTimestampFormat struct {
+	name  string
+	parse func(string, timestampParseOptions) (time.Time, error)
+}
+
+// namedTimestampFormats may be selected by name alongside timestampFormats.
+var namedTimestampFormats = []namedTimestampFormat{
+	{name: "http_date", parse: func(value string, options timestampParseOptions) (time.Time, error) {
+		return parseHTTPDate(value, options.reference)
+	}},
+	{name: "syslog_rfc3164", parse: func(value string, options timestampParseOptions) (time.Time, error) {
+		if options.reference == nil {
+			return time.Time{}, errors.New("RFC 3164 timestamps have no year, so a reference timestamp is required to infer it")
+		}
+		return parseRFC3164Timestamp(value, options.loc, *options.reference, options.yearInference)
+	}},
+	{name: "syslog_rfc5424", parse: ignoringParseOptions(parseRFC5424Timestamp)},
+	{name: "clf", parse: ignoringParseOptions(parseCLFTimestamp)},
+	{name: "generalized_time", parse: func(value string, options timestampParseOptions) (time.Time, error) {
+		return parseGeneralizedTime(value, options.loc)
+	}},
+	{name: "utctime", parse: ignoringParseOptions(parseUTCTime)},
+}
+
+var (
+	isoWeekDatePattern   = regexp.MustCompile(`^([0-9]{4})-?W([0-9]{2})-?([1-7])$`)
+	dashedOrdinalPattern = regexp.MustCompile(`^[0-9]{4}-[0-9]{3}(\.[0-9]+)?$`)
+)
+
+// parseAnyTimestamp parses value in the first of timestampFormats that
+// accepts it. Dates without a time are midnight UTC.
+func parseAnyTimestamp(value string) (time.Time, error) {
+	for _, format := range timestampFormats {
+		if t, err := format.parse(value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%q is not %s", value, timestampFormatSummary)
+}
+
+// timestampFormatNames lists the names of timestampFormats followed by those
+// of namedTimestampFormats.
+func timestampFormatNames() []string {
+	names := make([]string, 0, len(timestampFormats)+len(namedTimestampFormats))
+	for _, format := range timestampFormats {
+		names = append(names, format.name)
+	}
+	for _, format := range namedTimestampFormats {
+		names = append(names, format.name)
+	}
+
+	return names
+}
+
+// namedTimestampParser returns the parser of the format called name, which
+// may be any of timestampFormats or namedTimestampFormats.
+func namedTimestampParser(name string) (func(string, timestampParseOptions) (time.Time, error), error) {
+	for _, format := range timestampFormats {
+		if format.name == name {
+			return ignoringParseOptions(format.parse), nil
+		}
+	}
+
+	for _, format := range namedTimestampFormats {
+		if format.name == name {
+			return format.parse, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown format %q, expected one of: %s", name, strings.Join(timestampFormatNames(), ", "))
+}
+
+// ignoringParseOptions adapts a parser that needs no options to a
+// namedTimestampFormat.
+func ignoringParseOptions(parse func(string) (time.Time, error)) func(string, timestampParseOptions) (time.Time, error) {
+	return func(value string, _ timestampParseOptions) (time.Time, error) {
+		return parse(value)
+	}
+}
+
+// parseDate parses a YYYY-MM-DD calendar date as midnight UTC.
+func parseDate(value string) (time.Time, error) {
+	return time.Parse(time.DateOnly, value)
+}
+
+// parseISOWeekDate parses an ISO 8601 week date such as 2024-W03-3 as
+// midnight UTC on that day.
+func parseISOWeekDate(value string) (time.Time, error) {
+	m := isoWeekDatePattern.FindStringSubmatch(value)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("%q is not an ISO week date in YYYY-Www-D form", value)
+	}
+
+	year, _ := strconv.Atoi(m[1])
+	week, _ := strconv.Atoi(m[2])
+	day, _ := strconv.Atoi(m[3])
+
+	start, err := isoWeekStart(year, week)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return start.AddDate(0, 0, day-1), nil
+}
+
+// parseDashedOrdinalDate parses an ordinal date only in its YYYY-DDD form,
+// as the compact YYYYDDD form cannot be told apart from Unix seconds.
+func parseDashedOrdinalDate(value string) (time.Time, error) {
+	if !dashedOrdinalPattern.MatchString(value) {
+		return time.Time{}, fmt.Errorf("%q is not an ordinal date in YYYY-DDD form", value)
+	}
+
+	return parseOrdinalDate(value)
+}
+
+// parseUnixSeconds parses an integer number of seconds since the Unix epoch.
+func parseUnixSeconds(value string) (time.Time, error) {
+	if !integerPattern.MatchString(value) {
+		return time.Time{}, fmt.Errorf("%q is not an integer number of Unix seconds", value)
+	}
+
+	seconds, _ := new(big.Int).SetString(value, 10)
+
+	return timeFromUnixNanos(seconds.Mul(seconds, big.NewInt(int64(time.Second))))
+}
+
+// parseAnyTimestamps parses every element of values with parseAnyTimestamp,
+// identifying any invalid one by its index.
+func parseAnyTimestamps(values []string) ([]time.Time, error) {
+	times := make([]time.Time, 0, len(values))
+	for i, value := range values {
+		t, err := parseAnyTimestamp(value)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		times = append(times, t)
+	}
+
+	return times, nil
+}
+
+// extremeTimestamp returns the index of the first of values whose instant
+// compares as sign (-1 for earliest, 1 for latest) against all others.
+func extremeTimestamp(values []string, sign int) (int, error) {
+	if len(values) == 0 {
+		return 0, errors.New("list must not be empty")
+	}
+
+	times, err := parseAnyTimestamps(values)
+	if err != nil {
+		return 0, err
+	}
+
+	best := 0
+	for i, t := range times {
+		if t.Compare(times[best]) == sign {
+			best = i
+		}
+	}
+
+	return best, nil
+}
@@ -0,0 +1,235 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/lestrrat-go/strftime"
+)
+
+// zoneTimeAttributeTypes are the attributes of a timestamp on the wall clock
+// of a time zone, as returned by zoneTimes.
+var zoneTimeAttributeTypes = map[string]attr.Type{
+	"rfc3339":      types.StringType,
+	"offset":       types.StringType,
+	"abbreviation": types.StringType,
+	"is_dst":       types.BoolType,
+}
+
+// timestampAttributesModel holds the attributes derived from the timestamp a
+// resource stores, embedded in the resource's model.
+type timestampAttributesModel struct {
+	Unix      types.Int64  `tfsdk:"unix"`
+	UnixMs    types.Int64  `tfsdk:"unix_ms"`
+	Year      types.Int64  `tfsdk:"year"`
+	Month     types.Int64  `tfsdk:"month"`
+	Day       types.Int64  `tfsdk:"day"`
+	Hour      types.Int64  `tfsdk:"hour"`
+	Minute    types.Int64  `tfsdk:"minute"`
+	Second    types.Int64  `tfsdk:"second"`
+	Weekday   types.Int64  `tfsdk:"weekday"`
+	DayOfYear types.Int64  `tfsdk:"day_of_year"`
+	ISOYear   types.Int64  `tfsdk:"iso_year"`
+	ISOWeek   types.Int64  `tfsdk:"iso_week"`
+	JulianDay types.String `tfsdk:"julian_day"`
+	Formats   types.Map    `tfsdk:"formats"`
+	Formatted types.Map    `tfsdk:"formatted"`
+}
+
+// timestampAttributes returns the schema of timestampAttributesModel, where
+// subject names the stored timestamp in descriptions. Calendar fields are
+// read in the timestamp's own offset.
+func timestampAttributes(subject string) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"formats": schema.MapAttribute{
+			Description: "Map of names to strftime format strings (e.g., { date = \"%Y-%m-%d\" }) to render " + subject + " with in formatted.",
+			ElementType: types.StringType,
+			Optional:    true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"formatted": schema.MapAttribute{
+			Description: "Map of the names in formats to " + subject + " rendered with each format.",
+			ElementType: types.StringType,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"julian_day": schema.StringAttribute{
+			Description: "Julian Day of " + subject + ", with a fractional part for the time of day.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+
+	integers := map[string]string{
+		"unix":        "Number of seconds since the Unix epoch of " + subject + ".",
+		"unix_ms":     "Number of milliseconds since the Unix epoch of " + subject + ".",
+		"year":        "Year of " + subject + ".",
+		"month":       "Month of " + subject + ", from 1 to 12.",
+		"day":         "Day of the month of " + subject + ".",
+		"hour":        "Hour of " + subject + ", from 0 to 23.",
+		"minute":      "Minute of " + subject + ".",
+		"second":      "Second of " + subject + ".",
+		"weekday":     "Day of the week of " + subject + ", from 0 (Sunday) to 6 (Saturday).",
+		"day_of_year": "Day of the year of " + subject + ", from 1 to 366.",
+		"iso_year":    "ISO 8601 week-numbering year of " + subject + ".",
+		"iso_week":    "ISO 8601 week number of " + subject + ", from 1 to 53.",
+	}
+	for name, description := range integers {
+		attributes[name] = schema.Int64Attribute{
+			Description: description,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	return attributes
+}
+
+// validateFormats checks that every value in formats is a valid strftime
+// format.
+func validateFormats(ctx context.Context, formats types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if formats.IsNull() || formats.IsUnknown() {
+		return diags
+	}
+
+	for name, value := range formats.Elements() {
+		format, ok := value.(types.String)
+		if !ok || format.IsNull() || format.IsUnknown() {
+			continue
+		}
+
+		if _, err := strftime.New(format.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("formats").AtMapKey(name), "Invalid strftime format", err.Error())
+		}
+	}
+
+	return diags
+}
+
+// set fills the derived attributes from t, keeping the configured formats.
+func (m *timestampAttributesModel) set(ctx context.Context, t time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	isoYear, isoWeek := t.ISOWeek()
+
+	m.Unix = types.Int64Value(t.Unix())
+	m.UnixMs = types.Int64Value(t.UnixMilli())
+	m.Year = types.Int64Value(int64(t.Year()))
+	m.Month = types.Int64Value(int64(t.Month()))
+	m.Day = types.Int64Value(int64(t.Day()))
+	m.Hour = types.Int64Value(int64(t.Hour()))
+	m.Minute = types.Int64Value(int64(t.Minute()))
+	m.Second = types.Int64Value(int64(t.Second()))
+	m.Weekday = types.Int64Value(int64(t.Weekday()))
+	m.DayOfYear = types.Int64Value(int64(t.YearDay()))
+	m.ISOYear = types.Int64Value(int64(isoYear))
+	m.ISOWeek = types.Int64Value(int64(isoWeek))
+	m.JulianDay = types.StringValue(formatFractionalDays(daysSinceEpoch(t, julianDayUnixEpoch)))
+
+	var formatDiags diag.Diagnostics
+	m.Formatted, formatDiags = renderFormats(ctx, m.Formats, t)
+	diags.Append(formatDiags...)
+
+	return diags
+}
+
+// renderFormats renders t with each strftime format in the formats map.
+func renderFormats(ctx context.Context, formats types.Map, t time.Time) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	patterns := map[string]string{}
+	diags.Append(formats.ElementsAs(ctx, &patterns, false)...)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	formatted := make(map[string]attr.Value, len(patterns))
+	for name, format := range patterns {
+		formatter, err := strftime.New(format)
+		if err != nil {
+			diags.AddAttributeError(path.Root("formats").AtMapKey(name), "Invalid strftime format", err.Error())
+			continue
+		}
+		formatted[name] = types.StringValue(formatter.FormatString(t))
+	}
+
+	return types.MapValueMust(types.StringType, formatted), diags
+}
+
+// validateZones checks that every value in zones is a known time zone.
+func validateZones(zones types.List) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if zones.IsNull() || zones.IsUnknown() {
+		return diags
+	}
+
+	for i, value := range zones.Elements() {
+		zone, ok := value.(types.String)
+		if !ok || zone.IsNull() || zone.IsUnknown() {
+			continue
+		}
+
+		if _, err := loadLocation(zone.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("zones").AtListIndex(i), "Invalid time zone", err.Error())
+		}
+	}
+
+	return diags
+}
+
+// zoneTimes converts t into each time zone in zones, keyed by zone name.
+func zoneTimes(ctx context.Context, zones types.List, t time.Time) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objectType := types.ObjectType{AttrTypes: zoneTimeAttributeTypes}
+
+	var names []string
+	diags.Append(zones.ElementsAs(ctx, &names, false)...)
+	if diags.HasError() {
+		return types.MapNull(objectType), diags
+	}
+
+	localTimes := make(map[string]attr.Value, len(names))
+	for i, zone := range names {
+		loc, err := loadLocation(zone)
+		if err != nil {
+			diags.AddAttributeError(path.Root("zones").AtListIndex(i), "Invalid time zone", err.Error())
+			return types.MapNull(objectType), diags
+		}
+
+		local := t.In(loc)
+		_, offset := local.Zone()
+
+		localTimes[zone] = types.ObjectValueMust(zoneTimeAttributeTypes, map[string]attr.Value{
+			"rfc3339":      types.StringValue(formatTimestamp(local)),
+			"offset":       types.StringValue(formatOffset(offset)),
+			"abbreviation": types.StringValue(zoneAbbreviation(local)),
+			"is_dst":       types.BoolValue(local.IsDST()),
+		})
+	}
+
+	return types.MapValueMust(objectType, localTimes), diags
+}
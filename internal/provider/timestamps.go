@@ -0,0 +1,38 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+)
+
+// parseTimestamp parses an RFC3339 timestamp, including any fractional
+// seconds, as accepted by every function in this provider.
+func parseTimestamp(value string) (time.Time, error) {
+	return time.Parse(time.RFC3339, value)
+}
+
+// formatTimestamp renders t as RFC3339, only including fractional seconds
+// when they are non-zero.
+func formatTimestamp(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+// minTime returns the earlier of a and b.
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+
+	return a
+}
+
+// maxTime returns the later of a and b.
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+
+	return a
+}
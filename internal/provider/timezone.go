@@ -0,0 +1,232 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	// Embed the IANA time zone database so results do not depend on the
+	// zoneinfo files installed on the machine running Terraform.
+	_ "time/tzdata"
+)
+
+// zoneTransition is a change of UTC offset or abbreviation in a time zone.
+type zoneTransition struct {
+	at           time.Time
+	offset       int
+	abbreviation string
+	isDST        bool
+}
+
+// loadLocation loads an IANA time zone by name. Unlike time.LoadLocation it
+// rejects the empty string and "Local", which depend on the machine running
+// Terraform rather than the configuration.
+func loadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return nil, errors.New("time zone name must not be empty")
+	}
+
+	if name == "Local" {
+		return nil, errors.New(`the "Local" time zone is not supported, use an IANA time zone name`)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+
+	return loc, nil
+}
+
+// optionalLocation loads the time zone given by an optional variadic
+// argument, defaulting to the fixed offset of timestamp t when it is omitted
+// or empty.
+func optionalLocation(zones []string, t time.Time) (*time.Location, error) {
+	switch {
+	case len(zones) > 1:
+		return nil, errors.New("at most one time zone may be supplied")
+	case len(zones) == 0 || zones[0] == "":
+		return t.Location(), nil
+	default:
+		return loadLocation(zones[0])
+	}
+}
+
+// formatOffset renders a UTC offset in seconds as +HH:MM, including seconds
+// only for the historical zones that need them.
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+
+	if offset%60 != 0 {
+		return fmt.Sprintf("%c%02d:%02d:%02d", sign, offset/3600, offset/60%60, offset%60)
+	}
+
+	return fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset/60%60)
+}
+
+// zoneAbbreviation returns the abbreviation in effect at t, falling back to
+// the numeric offset for zones whose tzdata only has numeric names.
+func zoneAbbreviation(t time.Time) string {
+	name, offset := t.Zone()
+	if name == "" || strings.HasPrefix(name, "+") || strings.HasPrefix(name, "-") {
+		return formatOffset(offset)
+	}
+
+	return name
+}
+
+// nextZoneTransition returns the first transition in loc strictly after t,
+// or false if the zone has no further transitions. Past the last explicit
+// tzdata transition ZoneBounds also reports boundaries at the end of each
+// year that change nothing, and querying at one can return the same
+// boundary again, so those are skipped by moving on to the next year.
+func nextZoneTransition(loc *time.Location, t time.Time) (zoneTransition, bool) {
+	for {
+		local := t.In(loc)
+
+		_, end := local.ZoneBounds()
+		if end.IsZero() {
+			return zoneTransition{}, false
+		}
+
+		if !end.After(t) {
+			end = time.Date(local.Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+		}
+
+		at := end.In(loc)
+		name, offset := at.Zone()
+
+		if previousName, previousOffset := local.Zone(); name != previousName || offset != previousOffset || at.IsDST() != local.IsDST() {
+			return zoneTransition{
+				at:           at,
+				offset:       offset,
+				abbreviation: zoneAbbreviation(at),
+				isDST:        at.IsDST(),
+			}, true
+		}
+
+		t = end
+	}
+}
+
+// zoneTransitions returns the transitions in loc after start, stopping after
+// limit transitions or at end, whichever comes first. A zero end means no
+// upper bound and a non-positive limit means no limit, but not both.
+func zoneTransitions(loc *time.Location, start, end time.Time, limit int) []zoneTransition {
+	var transitions []zoneTransition
+
+	if limit <= 0 && end.IsZero() {
+		return nil
+	}
+
+	for t := start; limit <= 0 || len(transitions) < limit; {
+		next, ok := nextZoneTransition(loc, t)
+		if !ok || (!end.IsZero() && next.at.After(end)) {
+			break
+		}
+
+		transitions = append(transitions, next)
+		t = next.at
+	}
+
+	return transitions
+}
+
+// Disambiguation policies for local times that occur twice (ambiguous) or
+// not at all (skipped) because of an offset transition, following the
+// Temporal proposal's disambiguation option.
+const (
+	disambiguationCompatible = "compatible"
+	disambiguationEarlier    = "earlier"
+	disambiguationLater      = "later"
+	disambiguationReject     = "reject"
+)
+
+var disambiguationPolicies = []string{
+	disambiguationCompatible,
+	disambiguationEarlier,
+	disambiguationLater,
+	disambiguationReject,
+}
+
+// localDateTimeLayouts are the accepted forms of a local date and time
+// without an offset. Fractional seconds are accepted after the seconds.
+var localDateTimeLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+}
+
+// parseLocalDateTime parses a wall clock date and time without an offset,
+// returning it as the UTC instant with the same fields.
+func parseLocalDateTime(value string) (time.Time, error) {
+	for _, layout := range localDateTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%q is not a local date and time in YYYY-MM-DDTHH:MM:SS form without an offset", value)
+}
+
+// resolveLocalTime finds the instant at which the wall clock in loc shows the
+// fields of wall, applying policy when that wall time is ambiguous or was
+// skipped by a transition.
+func resolveLocalTime(wall time.Time, loc *time.Location, policy string) (time.Time, error) {
+	if !slices.Contains(disambiguationPolicies, policy) {
+		return time.Time{}, fmt.Errorf("unknown disambiguation %q, expected one of: %s", policy, strings.Join(disambiguationPolicies, ", "))
+	}
+
+	// Offsets in effect a day either side of the wall time cover every
+	// candidate, as no zone changes offset twice within two days.
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+
+	earlier := wall.Add(-time.Duration(max(before, after)) * time.Second)
+	later := wall.Add(-time.Duration(min(before, after)) * time.Second)
+
+	var candidates []time.Time
+	for _, c := range []time.Time{earlier, later} {
+		_, offset := c.In(loc).Zone()
+		if c.Add(time.Duration(offset)*time.Second).Equal(wall) && !slices.ContainsFunc(candidates, c.Equal) {
+			candidates = append(candidates, c)
+		}
+	}
+
+	local := wall.Format("2006-01-02T15:04:05.999999999")
+
+	switch {
+	case len(candidates) == 1:
+		return candidates[0], nil
+	case len(candidates) > 1:
+		switch policy {
+		case disambiguationReject:
+			return time.Time{}, fmt.Errorf("%s is ambiguous in %s, occurring at both %s and %s; disambiguation %q does not allow ambiguous local times, use %q or %q to choose one",
+				local, loc, formatTimestamp(candidates[0].In(loc)), formatTimestamp(candidates[1].In(loc)), policy, disambiguationEarlier, disambiguationLater)
+		case disambiguationLater:
+			return candidates[1], nil
+		default:
+			return candidates[0], nil
+		}
+	default:
+		switch policy {
+		case disambiguationReject:
+			return time.Time{}, fmt.Errorf("%s does not exist in %s because the offset changed from %s to %s, skipping it; disambiguation %q does not allow skipped local times, use %q or %q to shift it",
+				local, loc, formatOffset(before), formatOffset(after), policy, disambiguationEarlier, disambiguationLater)
+		case disambiguationEarlier:
+			return earlier, nil
+		default:
+			return later, nil
+		}
+	}
+}
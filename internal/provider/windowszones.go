@@ -0,0 +1,124 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	_ "embed"
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// windowsDefaultTerritory is the CLDR territory holding the default IANA zone
+// for each Windows time zone ID.
+const windowsDefaultTerritory = "001"
+
+//go:embed data/windowsZones.xml
+var windowsZonesXML []byte
+
+// windowsZoneData indexes the CLDR windowsZones mappings in both directions.
+type windowsZoneData struct {
+	// zones maps a Windows time zone ID to its IANA zones by territory.
+	zones map[string]map[string][]string
+	// windowsIDs maps a canonical IANA zone to its Windows time zone ID.
+	windowsIDs map[string]string
+	// names holds every Windows time zone ID in sorted order.
+	names []string
+}
+
+var windowsZones = sync.OnceValues(func() (*windowsZoneData, error) {
+	var doc struct {
+		MapZones []struct {
+			Other     string `xml:"other,attr"`
+			Territory string `xml:"territory,attr"`
+			Type      string `xml:"type,attr"`
+		} `xml:"windowsZones>mapTimezones>mapZone"`
+	}
+
+	if err := xml.Unmarshal(windowsZonesXML, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse embedded windowsZones.xml: %w", err)
+	}
+
+	data := &windowsZoneData{
+		zones:      map[string]map[string][]string{},
+		windowsIDs: map[string]string{},
+	}
+
+	for _, m := range doc.MapZones {
+		if data.zones[m.Other] == nil {
+			data.zones[m.Other] = map[string][]string{}
+			data.names = append(data.names, m.Other)
+		}
+
+		zones := strings.Fields(m.Type)
+		data.zones[m.Other][m.Territory] = zones
+
+		for _, zone := range zones {
+			canonical := canonicalZoneName(zone)
+			if _, ok := data.windowsIDs[canonical]; !ok || m.Territory == windowsDefaultTerritory {
+				data.windowsIDs[canonical] = m.Other
+			}
+		}
+	}
+
+	slices.Sort(data.names)
+
+	return data, nil
+})
+
+// windowsToIANA returns the canonical IANA zone for a Windows time zone ID,
+// using the mapping for territory when CLDR has one and the default mapping
+// otherwise. Windows IDs are matched case-insensitively.
+func windowsToIANA(name, territory string) (string, error) {
+	data, err := windowsZones()
+	if err != nil {
+		return "", err
+	}
+
+	var territories map[string][]string
+	for _, id := range data.names {
+		if strings.EqualFold(id, name) {
+			territories = data.zones[id]
+			break
+		}
+	}
+
+	if territories == nil {
+		return "", fmt.Errorf("unknown Windows time zone %q%s", name, suggestionSuffix(name, data.names))
+	}
+
+	zones, ok := territories[strings.ToUpper(territory)]
+	if !ok || len(zones) == 0 {
+		zones = territories[windowsDefaultTerritory]
+	}
+
+	return canonicalZoneName(zones[0]), nil
+}
+
+// ianaToWindows returns the Windows time zone ID covering an IANA zone or
+// one of its link names.
+func ianaToWindows(zone string) (string, error) {
+	data, err := windowsZones()
+	if err != nil {
+		return "", err
+	}
+
+	if id, ok := data.windowsIDs[canonicalZoneName(zone)]; ok {
+		return id, nil
+	}
+
+	if _, err := loadLocation(zone); err == nil {
+		return "", fmt.Errorf("time zone %q has no Windows equivalent", zone)
+	}
+
+	candidates := make([]string, 0, len(data.windowsIDs))
+	for z := range data.windowsIDs {
+		candidates = append(candidates, z)
+	}
+	slices.Sort(candidates)
+
+	return "", fmt.Errorf("unknown time zone %q%s", zone, suggestionSuffix(zone, candidates))
+}
@@ -0,0 +1,40 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+//go:embed data/zone_links.txt
+var zoneLinksData string
+
+// zoneLinks maps alias time zone names such as "US/Eastern" to the canonical
+// zone they link to.
+var zoneLinks = sync.OnceValue(func() map[string]string {
+	links := map[string]string{}
+
+	scanner := bufio.NewScanner(strings.NewReader(zoneLinksData))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 3 && fields[0] == "Link" {
+			links[fields[2]] = fields[1]
+		}
+	}
+
+	return links
+})
+
+// canonicalZoneName resolves a link name to its canonical zone, returning
+// any other name unchanged.
+func canonicalZoneName(name string) string {
+	if target, ok := zoneLinks()[name]; ok {
+		return target
+	}
+
+	return name
+}
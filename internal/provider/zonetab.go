@@ -0,0 +1,73 @@
+// Copyright (C) Aaron Edwards
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	_ "embed"
+	"slices"
+	"strings"
+	"sync"
+)
+
+//go:embed data/zone1970.tab
+var zone1970Data string
+
+// canonicalZone is a row of the IANA zone1970.tab table.
+type canonicalZone struct {
+	name         string
+	countryCodes []string
+	comment      string
+}
+
+// canonicalZones lists the canonical IANA time zones sorted by name.
+var canonicalZones = sync.OnceValue(func() []canonicalZone {
+	var zones []canonicalZone
+
+	scanner := bufio.NewScanner(strings.NewReader(zone1970Data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			continue
+		}
+
+		zone := canonicalZone{
+			name:         fields[2],
+			countryCodes: strings.Split(fields[0], ","),
+		}
+		if len(fields) > 3 {
+			zone.comment = fields[3]
+		}
+
+		zones = append(zones, zone)
+	}
+
+	slices.SortFunc(zones, func(a, b canonicalZone) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	return zones
+})
+
+// zoneNameCandidates returns every canonical zone and link name, for
+// suggesting corrections to misspelt time zone names.
+func zoneNameCandidates() []string {
+	var names []string
+	for _, zone := range canonicalZones() {
+		names = append(names, zone.name)
+	}
+
+	for alias := range zoneLinks() {
+		names = append(names, alias)
+	}
+
+	slices.Sort(names)
+
+	return names
+}